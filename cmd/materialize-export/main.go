@@ -0,0 +1,70 @@
+// Exports the objects of an existing Materialize environment to Terraform
+// configuration. The command connects with the same arguments as the provider
+// which are read from the `MZ_*` environment variables.
+//
+//	MZ_HOST=... MZ_USER=... MZ_PASSWORD=... go run ./cmd/materialize-export -out ./materialize
+//
+// Sources, sinks and connections are exported from their `create_sql`. The
+// provider does not read most of their options back, so the generated
+// resources ignore changes to those attributes. Objects that the provider
+// cannot describe, such as sources with options it does not support, are
+// skipped with the reason. The command exits with an error when it skips
+// objects unless `-allow-skipped` is set.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/exporter"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func main() {
+	out := flag.String("out", ".", "The directory to write the Terraform files to.")
+	databases := flag.String("databases", "", "A comma separated list of databases to export. Defaults to every database.")
+	allowSkipped := flag.Bool("allow-skipped", false, "Exit successfully when objects are skipped. The export does not describe the skipped objects.")
+	flag.Parse()
+
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		for _, d := range diags {
+			log.Printf("%s: %s", d.Summary, d.Detail)
+		}
+		os.Exit(1)
+	}
+
+	conn := p.Meta().(*sqlx.DB)
+	defer conn.Close()
+
+	var filter []string
+	if *databases != "" {
+		filter = strings.Split(*databases, ",")
+	}
+
+	e := exporter.NewExporter(conn, filter)
+	if err := e.Export(); err != nil {
+		log.Fatalf("unable to export: %s", err)
+	}
+
+	if err := e.Write(*out); err != nil {
+		log.Fatalf("unable to write files: %s", err)
+	}
+
+	skipped := e.Skipped()
+	for _, s := range skipped {
+		fmt.Fprintf(os.Stderr, "skipped %s\n", s)
+	}
+
+	// A partial export would plan to leave the skipped objects unmanaged
+	if len(skipped) > 0 && !*allowSkipped {
+		fmt.Fprintf(os.Stderr, "the export is incomplete, %d objects were skipped. Use -allow-skipped to accept a partial export\n", len(skipped))
+		os.Exit(1)
+	}
+}
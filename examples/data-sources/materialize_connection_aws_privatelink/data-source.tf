@@ -0,0 +1,23 @@
+resource "materialize_connection_aws_privatelink" "example_privatelink_connection" {
+  name               = "example_privatelink_connection"
+  service_name       = "com.amazonaws.us-east-1.materialize.example"
+  availability_zones = ["use1-az2", "use1-az6"]
+}
+
+# Allow the Materialize principal to connect to the VPC endpoint service
+resource "aws_vpc_endpoint_service_allowed_principal" "example_privatelink_connection" {
+  vpc_endpoint_service_id = "vpce-svc-0e123abc123198abc"
+  principal_arn           = materialize_connection_aws_privatelink.example_privatelink_connection.principal
+}
+
+# Wait for the endpoint to become available once the principal is allowed
+data "materialize_connection_aws_privatelink" "example_privatelink_connection" {
+  name               = materialize_connection_aws_privatelink.example_privatelink_connection.name
+  wait_for_available = true
+
+  depends_on = [aws_vpc_endpoint_service_allowed_principal.example_privatelink_connection]
+}
+
+output "privatelink_status" {
+  value = data.materialize_connection_aws_privatelink.example_privatelink_connection.status
+}
@@ -0,0 +1,19 @@
+# Validated on every plan, a failed validation is reported in `error`
+data "materialize_connection_validation" "postgres_connection" {
+  name          = "postgres_connection"
+  schema_name   = "public"
+  database_name = "materialize"
+
+  lifecycle {
+    postcondition {
+      condition     = self.valid
+      error_message = "Connection postgres_connection failed validation: ${self.error}"
+    }
+  }
+}
+
+# Fails the plan with the validation error
+data "materialize_connection_validation" "kafka_connection" {
+  name          = "kafka_connection"
+  fail_on_error = true
+}
@@ -0,0 +1,6 @@
+# The network policy active in the region
+data "materialize_network_policy" "active" {}
+
+output "allowed_addresses" {
+  value = data.materialize_network_policy.active.rules[*].address
+}
@@ -7,4 +7,9 @@ data "materialize_source" "materialize" {
 data "materialize_source" "materialize_schema" {
   database_name = "materialize"
   schema_name   = "schema"
-}
\ No newline at end of file
+}
+
+data "materialize_source" "prod" {
+  database_name = "materialize"
+  name_pattern  = "prod_%"
+}
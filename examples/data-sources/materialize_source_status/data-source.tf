@@ -0,0 +1,16 @@
+data "materialize_source_status" "all" {}
+
+data "materialize_source_status" "materialize_schema" {
+  database_name = "materialize"
+  schema_name   = "schema"
+}
+
+data "materialize_source_status" "orders" {
+  name          = "orders"
+  database_name = "materialize"
+  schema_name   = "public"
+}
+
+output "stalled_sources" {
+  value = [for s in data.materialize_source_status.all.statuses : s.name if s.status == "stalled"]
+}
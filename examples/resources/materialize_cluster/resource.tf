@@ -1,3 +1,26 @@
 resource "materialize_cluster" "example_cluster" {
   name = "cluster"
-}
\ No newline at end of file
+}
+
+# Resize gracefully, the new replicas hydrate before replacing the existing ones
+resource "materialize_cluster" "example_managed_cluster" {
+  name = "managed_cluster"
+  size = "3xsmall"
+
+  wait_until_ready {
+    enabled    = true
+    timeout    = "10 minutes"
+    on_timeout = "COMMIT"
+  }
+}
+
+# Only turned on while the materialized views it maintains refresh
+resource "materialize_cluster" "example_scheduled_cluster" {
+  name = "scheduled_cluster"
+  size = "3xsmall"
+
+  scheduling {
+    on_refresh              = true
+    hydration_time_estimate = "1 hour"
+  }
+}
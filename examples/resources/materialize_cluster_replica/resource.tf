@@ -2,4 +2,15 @@ resource "materialize_cluster_replica" "example_cluster_replica" {
   name         = "replica"
   cluster_name = "cluster"
   size         = "2xsmall"
-}
\ No newline at end of file
+}
+# Wait until every process of the replica is ready before completing
+resource "materialize_cluster_replica" "example_cluster_replica_ready" {
+  name           = "replica_ready"
+  cluster_name   = "cluster"
+  size           = "2xsmall"
+  wait_for_ready = true
+
+  timeouts {
+    create = "5m"
+  }
+}
@@ -0,0 +1,4 @@
+#Connections can be imported using the connection id:
+terraform import materialize_connection_aws.example <connection_id>
+
+# Connection id and information be found in the `mz_catalog.mz_connections` table
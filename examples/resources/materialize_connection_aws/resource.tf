@@ -0,0 +1,60 @@
+# Assume an IAM role in your AWS account
+resource "materialize_connection_aws" "example_aws_connection" {
+  name            = "example_aws_connection"
+  schema_name     = "public"
+  region          = "us-east-1"
+  assume_role_arn = "arn:aws:iam::000000000000:role/MaterializeMSK"
+}
+
+# CREATE CONNECTION example_aws_connection TO AWS (
+#     REGION = 'us-east-1',
+#     ASSUME ROLE ARN = 'arn:aws:iam::000000000000:role/MaterializeMSK'
+# );
+
+# The role trusts the principal of the connection for its external id
+data "aws_iam_policy_document" "materialize_trust" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "AWS"
+      identifiers = [materialize_connection_aws.example_aws_connection.principal]
+    }
+    condition {
+      test     = "StringEquals"
+      variable = "sts:ExternalId"
+      values   = [materialize_connection_aws.example_aws_connection.external_id]
+    }
+  }
+}
+
+# Static credentials held in secrets
+resource "materialize_connection_aws" "example_aws_credentials" {
+  name   = "example_aws_credentials"
+  region = "us-east-1"
+  access_key_id {
+    secret {
+      name = "aws_access_key_id"
+    }
+  }
+  secret_access_key {
+    name = "aws_secret_access_key"
+  }
+}
+
+# CREATE CONNECTION example_aws_credentials TO AWS (
+#     REGION = 'us-east-1',
+#     ACCESS KEY ID = SECRET aws_access_key_id,
+#     SECRET ACCESS KEY = SECRET aws_secret_access_key
+# );
+
+# Amazon MSK with IAM access control
+resource "materialize_connection_kafka" "example_msk" {
+  name = "example_msk"
+  kafka_broker {
+    broker = "b-1.example.kafka.us-east-1.amazonaws.com:9098"
+  }
+  aws_connection {
+    name = materialize_connection_aws.example_aws_connection.name
+  }
+  validate = false
+}
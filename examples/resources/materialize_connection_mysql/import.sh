@@ -0,0 +1,2 @@
+# Connections can be imported using the connection id:
+terraform import materialize_connection_mysql.example <connection_id>
\ No newline at end of file
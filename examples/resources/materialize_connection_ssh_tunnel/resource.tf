@@ -12,3 +12,15 @@ resource "materialize_connection_ssh_tunnel" "example_ssh_connection" {
 #    PORT 22,
 #    USER 'example'
 # );
+
+# Rotate the keys of the SSH tunnel whenever the trigger changes
+resource "materialize_connection_ssh_tunnel" "example_ssh_connection_rotated" {
+  name                = "ssh_example_connection_rotated"
+  schema_name         = "public"
+  host                = "example.com"
+  port                = 22
+  user                = "example"
+  rotate_keys_trigger = "2024-01-01"
+}
+
+# ALTER CONNECTION ssh_example_connection_rotated ROTATE KEYS;
@@ -0,0 +1,5 @@
+# Deployments can be imported using the production database, schema and cluster:
+terraform import materialize_deployment.example '<database_name>|<schema_name>|<cluster_name>'
+
+# Deployments with custom shadow names also include the shadow schema and cluster:
+terraform import materialize_deployment.example '<database_name>|<schema_name>|<cluster_name>|<shadow_schema_name>|<shadow_cluster_name>'
@@ -0,0 +1,18 @@
+resource "materialize_deployment" "example_deployment" {
+  schema_name   = "schema"
+  database_name = "database"
+  cluster_name  = "cluster"
+
+  # Set to true once the new objects have been created in the shadow
+  # schema and cluster to swap them into production
+  promote = false
+}
+
+# Deploy new objects into the shadow schema and cluster
+resource "materialize_materialized_view" "example_materialized_view" {
+  name          = "materialized_view"
+  schema_name   = materialize_deployment.example_deployment.shadow_schema_name
+  database_name = materialize_deployment.example_deployment.database_name
+  cluster_name  = materialize_deployment.example_deployment.shadow_cluster_name
+  statement     = "SELECT 1 AS id"
+}
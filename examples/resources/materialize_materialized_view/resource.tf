@@ -20,3 +20,22 @@ resource "materialize_materialized_view" "simple_materialized_view" {
 
   statement = "SELECT * FROM materialize.public.simple_table"
 }
+
+# Refresh a daily rollup once a day at 03:00 UTC and keep an hour of history
+resource "materialize_materialized_view" "daily_rollup" {
+  name          = "daily_rollup"
+  schema_name   = materialize_schema.schema.name
+  database_name = materialize_database.database.name
+
+  statement = "SELECT count(*) FROM materialize.public.simple_table"
+
+  refresh {
+    at_creation = true
+    every {
+      interval   = "1 day"
+      aligned_to = "2024-01-01 03:00:00"
+    }
+  }
+
+  retain_history = "1 hour"
+}
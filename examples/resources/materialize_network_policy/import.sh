@@ -0,0 +1,4 @@
+# Network policies can be imported using the network policy id:
+terraform import materialize_network_policy.example_network_policy <network_policy_id>
+
+# Network policy id and information be found in the `mz_internal.mz_network_policies` table
@@ -0,0 +1,17 @@
+resource "materialize_network_policy" "office_access_policy" {
+  name = "office_access_policy"
+
+  rule {
+    name      = "new_york"
+    action    = "allow"
+    direction = "ingress"
+    address   = "1.2.3.4/28"
+  }
+
+  rule {
+    name      = "minnesota"
+    action    = "allow"
+    direction = "ingress"
+    address   = "2.3.4.5/32"
+  }
+}
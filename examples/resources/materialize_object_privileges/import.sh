@@ -0,0 +1,2 @@
+#Object privileges can be imported using the concatenation of PRIVILEGES, the object type and the id of the object
+terraform import materialize_object_privileges.example "PRIVILEGES|TABLE|<table_id>"
@@ -0,0 +1,17 @@
+# Privileges on the table granted to other roles are revoked
+resource "materialize_object_privileges" "example" {
+  object_type   = "TABLE"
+  name          = "example_table"
+  schema_name   = "schema"
+  database_name = "database"
+
+  grant {
+    role_name  = "example_role"
+    privileges = ["SELECT", "INSERT"]
+  }
+
+  grant {
+    role_name  = "PUBLIC"
+    privileges = ["SELECT"]
+  }
+}
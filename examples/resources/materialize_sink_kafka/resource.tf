@@ -29,3 +29,47 @@ resource "materialize_sink_kafka" "example_sink_kafka" {
 #   FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection"
 #   ENVELOPE UPSERT
 #   WITH (SIZE = '3xsmall');
+
+resource "materialize_sink_kafka" "example_sink_kafka_options" {
+  name         = "sink_kafka_options"
+  schema_name  = "schema"
+  cluster_name = "sinks"
+  from {
+    name = "table"
+  }
+  topic                    = "orders"
+  compression_type         = "zstd"
+  topic_partition_count    = 6
+  topic_replication_factor = 3
+  topic_config = {
+    "cleanup.policy" = "compact"
+  }
+  key              = ["id"]
+  key_not_enforced = true
+  key_format {
+    text = true
+  }
+  value_format {
+    json = true
+  }
+  kafka_connection {
+    name = "kafka_connection"
+  }
+  envelope {
+    upsert = true
+  }
+}
+
+# CREATE SINK schema.sink_kafka_options
+#   IN CLUSTER "sinks"
+#   FROM schema.table
+#   INTO KAFKA CONNECTION "kafka_connection" (
+#     TOPIC 'orders',
+#     COMPRESSION TYPE = 'zstd',
+#     TOPIC PARTITION COUNT = 6,
+#     TOPIC REPLICATION FACTOR = 3,
+#     TOPIC CONFIG = MAP['cleanup.policy' => 'compact']
+#   )
+#   KEY (id) NOT ENFORCED
+#   KEY FORMAT TEXT VALUE FORMAT JSON
+#   ENVELOPE UPSERT;
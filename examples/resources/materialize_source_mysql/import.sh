@@ -0,0 +1,4 @@
+# Sources can be imported using the source id:
+terraform import materialize_source_mysql.example_source_mysql <source_id>
+
+# Source id and information be found in the `mz_catalog.mz_sources` table
\ No newline at end of file
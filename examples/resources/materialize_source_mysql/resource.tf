@@ -0,0 +1,29 @@
+resource "materialize_source_mysql" "example_source_mysql" {
+  name        = "source_mysql"
+  schema_name = "schema"
+  size        = "3xsmall"
+
+  mysql_connection {
+    name = "mysql_connection"
+    # Optional parameters
+    # database_name = "materialize"
+    # schema_name = "public"
+  }
+
+  table {
+    name  = "shop.orders"
+    alias = "orders"
+  }
+
+  table {
+    name  = "shop.customers"
+    alias = "customers"
+  }
+
+  ignore_columns = ["shop.customers.internal_notes"]
+}
+
+# CREATE SOURCE schema.source_mysql
+#   FROM MYSQL CONNECTION "database"."schema"."mysql_connection" (IGNORE COLUMNS (shop.customers.internal_notes))
+#   FOR TABLES (shop.orders AS orders, shop.customers AS customers)
+#   WITH (SIZE = '3xsmall');
@@ -0,0 +1,4 @@
+# Source tables can be imported using the table id:
+terraform import materialize_source_table.example_source_table <table_id>
+
+# Table id and information be found in the `mz_catalog.mz_tables` table
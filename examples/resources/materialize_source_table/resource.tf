@@ -0,0 +1,40 @@
+resource "materialize_source_table" "postgres_table" {
+  name          = "postgres_table"
+  schema_name   = "schema"
+  database_name = "database"
+
+  source {
+    name          = "postgres_source"
+    schema_name   = "public"
+    database_name = "materialize"
+  }
+
+  upstream_name        = "table1"
+  upstream_schema_name = "public"
+  text_columns         = ["id"]
+}
+
+resource "materialize_source_table" "kafka_table" {
+  name = "kafka_table"
+
+  source {
+    name = "kafka_source"
+  }
+
+  upstream_name = "topic1"
+  key_format {
+    text = true
+  }
+  value_format {
+    json = true
+  }
+  include_key       = true
+  include_key_alias = "message_key"
+  envelope {
+    upsert = true
+  }
+}
+
+# CREATE TABLE database.schema.postgres_table
+#   FROM SOURCE materialize.public.postgres_source (REFERENCE public.table1)
+#   WITH (TEXT COLUMNS (id));
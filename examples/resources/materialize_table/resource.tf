@@ -16,5 +16,10 @@ resource "materialize_table" "simple_table" {
     type     = "text"
     nullable = true
   }
+  column {
+    name    = "column_4"
+    type    = "timestamp"
+    default = "now()"
+  }
 
 }
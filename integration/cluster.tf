@@ -33,6 +33,22 @@ resource "materialize_cluster" "managed_cluster" {
   introspection_debugging       = true
   idle_arrangement_merge_effort = 2
   disk                          = true
+
+  wait_until_ready {
+    enabled    = true
+    timeout    = "10 minutes"
+    on_timeout = "COMMIT"
+  }
+}
+
+resource "materialize_cluster" "scheduled_cluster" {
+  name = "scheduled_cluster"
+  size = "3xsmall"
+
+  scheduling {
+    on_refresh              = true
+    hydration_time_estimate = "1 hour"
+  }
 }
 
 data "materialize_cluster" "all" {}
@@ -22,6 +22,8 @@ resource "materialize_connection_ssh_tunnel" "ssh_connection" {
   host = "ssh_host"
   user = "ssh_user"
   port = 22
+
+  rotate_keys_trigger = "initial"
 }
 
 resource "materialize_connection_kafka" "kafka_conn_multiple_brokers" {
@@ -45,6 +47,19 @@ resource "materialize_connection_kafka" "kafka_conn_multiple_brokers" {
   validate        = false
 }
 
+resource "materialize_connection_aws" "aws_connection" {
+  name    = "aws_connection"
+  comment = "connection aws comment"
+
+  region                   = "us-east-1"
+  assume_role_arn          = "arn:aws:iam::000000000000:role/materialize"
+  assume_role_session_name = "materialize"
+}
+
+output "aws_connection_principal" {
+  value = materialize_connection_aws.aws_connection.principal
+}
+
 resource "materialize_connection_postgres" "postgres_connection" {
   name    = "postgres_connection"
   comment = "connection postgres comment"
@@ -82,6 +97,22 @@ resource "materialize_connection_postgres" "postgres_connection_with_secret" {
   validate = false
 }
 
+resource "materialize_connection_mysql" "mysql_connection" {
+  name    = "mysql_connection"
+  comment = "connection mysql comment"
+
+  host = "mysql"
+  port = 3306
+  user {
+    text = "repluser"
+  }
+  password {
+    name          = materialize_secret.mysql_password.name
+    database_name = materialize_secret.mysql_password.database_name
+    schema_name   = materialize_secret.mysql_password.schema_name
+  }
+}
+
 resource "materialize_connection_grant" "connection_grant_usage" {
   role_name       = materialize_role.role_1.name
   privilege       = "USAGE"
@@ -102,4 +133,12 @@ output "qualified_ssh_connection" {
   value = materialize_connection_ssh_tunnel.ssh_connection.qualified_sql_name
 }
 
+output "ssh_connection_public_key_1" {
+  value = materialize_connection_ssh_tunnel.ssh_connection.public_key_1
+}
+
+output "ssh_connection_public_key_2" {
+  value = materialize_connection_ssh_tunnel.ssh_connection.public_key_2
+}
+
 data "materialize_connection" "all" {}
@@ -1 +1,11 @@
 data "materialize_egress_ips" "all" {}
+
+data "materialize_connection_validation" "postgres_connection" {
+  name          = materialize_connection_postgres.postgres_connection.name
+  schema_name   = materialize_connection_postgres.postgres_connection.schema_name
+  database_name = materialize_connection_postgres.postgres_connection.database_name
+}
+
+output "postgres_connection_valid" {
+  value = data.materialize_connection_validation.postgres_connection.valid
+}
@@ -24,6 +24,28 @@ SELECT
 SQL
 }
 
+resource "materialize_materialized_view" "materialized_view_refresh" {
+  name          = "materialized_view_refresh"
+  schema_name   = materialize_schema.schema.name
+  database_name = materialize_database.database.name
+  cluster_name  = "default"
+
+  refresh {
+    at_creation = true
+    every {
+      interval   = "1 day"
+      aligned_to = "2024-01-01 03:00:00"
+    }
+  }
+
+  retain_history = "1 hour"
+
+  statement = <<SQL
+SELECT
+    1 AS id
+SQL
+}
+
 resource "materialize_materialized_view_grant" "materialized_view_grant_select" {
   role_name              = materialize_role.role_1.name
   privilege              = "SELECT"
@@ -0,0 +1,23 @@
+CREATE USER 'repluser'@'%' IDENTIFIED BY 'c2VjcmV0Cg==';
+GRANT SELECT, RELOAD, SHOW DATABASES, REPLICATION SLAVE, REPLICATION CLIENT, LOCK TABLES ON *.* TO 'repluser'@'%';
+FLUSH PRIVILEGES;
+
+CREATE DATABASE shop;
+USE shop;
+
+CREATE TABLE table1 (
+    id INT NOT NULL AUTO_INCREMENT PRIMARY KEY
+);
+
+CREATE TABLE table2 (
+    id INT,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE table3 (
+    id INT NOT NULL AUTO_INCREMENT PRIMARY KEY
+);
+
+INSERT INTO table1 VALUES (1), (2), (3), (4), (5);
+INSERT INTO table2 VALUES (1, NOW()), (2, NOW()), (3, NOW()), (4, NOW()), (5, NOW());
+INSERT INTO table3 VALUES (1), (2), (3), (4), (5);
@@ -0,0 +1,22 @@
+resource "materialize_network_policy" "network_policy" {
+  name           = "integration_network_policy"
+  ownership_role = materialize_role.role_1.name
+
+  rule {
+    name    = "all_ipv4"
+    address = "0.0.0.0/0"
+  }
+
+  rule {
+    name    = "office"
+    address = "1.2.3.4/28"
+  }
+}
+
+data "materialize_network_policy" "network_policy" {
+  name = materialize_network_policy.network_policy.name
+}
+
+output "network_policy_rules" {
+  value = data.materialize_network_policy.network_policy.rules
+}
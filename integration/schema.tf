@@ -30,3 +30,19 @@ output "qualified_schema" {
 }
 
 data "materialize_schema" "all" {}
+
+resource "materialize_schema" "deployment_schema" {
+  name          = "deployment_schema"
+  database_name = materialize_database.database.name
+}
+
+resource "materialize_cluster" "deployment_cluster" {
+  name = "deployment_cluster"
+  size = "3xsmall"
+}
+
+resource "materialize_deployment" "deployment" {
+  schema_name   = materialize_schema.deployment_schema.name
+  database_name = materialize_schema.deployment_schema.database_name
+  cluster_name  = materialize_cluster.deployment_cluster.name
+}
@@ -11,6 +11,13 @@ resource "materialize_secret" "postgres_password" {
   value         = "c2VjcmV0Cg=="
 }
 
+resource "materialize_secret" "mysql_password" {
+  name          = "mysql_pass"
+  schema_name   = materialize_schema.schema.name
+  database_name = materialize_database.database.name
+  value         = "c2VjcmV0Cg=="
+}
+
 resource "materialize_secret" "kafka_password" {
   name          = "kafka_pass"
   schema_name   = materialize_schema.schema.name
@@ -26,6 +33,18 @@ resource "materialize_secret_grant" "secret_grant_usage" {
   database_name = materialize_secret.password.database_name
 }
 
+resource "materialize_object_privileges" "kafka_password_privileges" {
+  object_type   = "SECRET"
+  name          = materialize_secret.kafka_password.name
+  schema_name   = materialize_secret.kafka_password.schema_name
+  database_name = materialize_secret.kafka_password.database_name
+
+  grant {
+    role_name  = materialize_role.role_1.name
+    privileges = ["USAGE"]
+  }
+}
+
 resource "materialize_secret_grant_default_privilege" "example" {
   grantee_name     = materialize_role.grantee.name
   privilege        = "USAGE"
@@ -29,6 +29,36 @@ resource "materialize_sink_kafka" "sink_kafka" {
   }
 }
 
+resource "materialize_sink_kafka" "sink_kafka_options" {
+  name          = "sink_kafka_options"
+  schema_name   = materialize_schema.schema.name
+  database_name = materialize_database.database.name
+  cluster_name  = "default"
+  from {
+    name          = materialize_source_load_generator.load_generator.name
+    database_name = materialize_source_load_generator.load_generator.database_name
+    schema_name   = materialize_source_load_generator.load_generator.schema_name
+  }
+  topic                    = "topic_options"
+  compression_type         = "gzip"
+  topic_partition_count    = 2
+  topic_replication_factor = 1
+  topic_config = {
+    "cleanup.policy" = "compact"
+  }
+  format {
+    json = true
+  }
+  kafka_connection {
+    name          = materialize_connection_kafka.kafka_connection.name
+    database_name = materialize_connection_kafka.kafka_connection.database_name
+    schema_name   = materialize_connection_kafka.kafka_connection.schema_name
+  }
+  envelope {
+    debezium = true
+  }
+}
+
 output "qualified_sink_kafka" {
   value = materialize_sink_kafka.sink_kafka.qualified_sql_name
 }
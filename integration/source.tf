@@ -70,6 +70,27 @@ resource "materialize_source_postgres" "example_source_postgres_schema" {
   schema      = ["PUBLIC"]
 }
 
+resource "materialize_source_mysql" "example_source_mysql" {
+  name    = "source_mysql"
+  comment = "source mysql comment"
+
+  size = "3xsmall"
+  mysql_connection {
+    name          = materialize_connection_mysql.mysql_connection.name
+    schema_name   = materialize_connection_mysql.mysql_connection.schema_name
+    database_name = materialize_connection_mysql.mysql_connection.database_name
+  }
+  table {
+    name  = "shop.table1"
+    alias = "mysql_table1"
+  }
+  table {
+    name  = "shop.table2"
+    alias = "mysql_table2"
+  }
+  text_columns = ["shop.table1.id"]
+}
+
 resource "materialize_source_kafka" "example_source_kafka_format_text" {
   name    = "source_kafka_text"
   comment = "source kafka comment"
@@ -101,6 +122,11 @@ resource "materialize_source_kafka" "example_source_kafka_format_bytes" {
   format {
     bytes = true
   }
+
+  wait_for_ready = true
+  timeouts {
+    create = "5m"
+  }
 }
 
 resource "materialize_source_kafka" "example_source_kafka_format_avro" {
@@ -152,3 +178,28 @@ output "qualified_load_generator" {
 }
 
 data "materialize_source" "all" {}
+
+output "load_generator_status" {
+  value = materialize_source_load_generator.load_generator.status
+}
+
+data "materialize_source_status" "load_generator" {
+  name          = materialize_source_load_generator.load_generator.name
+  schema_name   = materialize_source_load_generator.load_generator.schema_name
+  database_name = materialize_source_load_generator.load_generator.database_name
+}
+
+resource "materialize_source_table" "example_source_table_postgres" {
+  name    = "source_table_postgres"
+  comment = "source table postgres comment"
+
+  source {
+    name          = materialize_source_postgres.example_source_postgres.name
+    schema_name   = materialize_source_postgres.example_source_postgres.schema_name
+    database_name = materialize_source_postgres.example_source_postgres.database_name
+  }
+
+  upstream_name        = "table3"
+  upstream_schema_name = "public"
+  text_columns         = ["id"]
+}
@@ -18,6 +18,11 @@ resource "materialize_table" "simple_table" {
     type     = "text"
     nullable = true
   }
+  column {
+    name    = "column_4"
+    type    = "timestamp"
+    default = "now()"
+  }
 
 }
 
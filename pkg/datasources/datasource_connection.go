@@ -25,6 +25,8 @@ func Connection() *schema.Resource {
 				Description:  "Limit connections to a specific schema within a specific database",
 				RequiredWith: []string{"database_name"},
 			},
+			"name_pattern": NamePatternSchema("connections"),
+			"names":        NamesSchema("connections"),
 			"connections": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -64,7 +66,7 @@ func connectionRead(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	var diags diag.Diagnostics
 
-	dataSource, err := materialize.ListConnections(meta.(*sqlx.DB), schemaName, databaseName)
+	dataSource, err := materialize.ListConnections(meta.(*sqlx.DB), schemaName, databaseName, NamePredicates("mz_connections.name", d)...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
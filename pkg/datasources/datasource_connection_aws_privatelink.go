@@ -0,0 +1,166 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+// Statuses of the VPC endpoint the connection cannot recover from
+var privatelinkTerminalStatuses = []string{"failed", "rejected", "expired", "deleted"}
+
+func ConnectionAwsPrivatelink() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: connectionAwsPrivatelinkRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the AWS PrivateLink connection.",
+			},
+			"schema_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "public",
+				Description: "The schema of the AWS PrivateLink connection.",
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MZ_DATABASE", "materialize"),
+				Description: "The database of the AWS PrivateLink connection. Defaults to `MZ_DATABASE` environment variable if set or `materialize` if environment variable is not set.",
+			},
+			"wait_for_available": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait until the connection is `available` before reading it. Polling is bounded by the data source read timeout.",
+			},
+			"qualified_sql_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fully qualified name of the AWS PrivateLink connection.",
+			},
+			"principal": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The AWS principal to add to the allowed principals of the VPC endpoint service.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the AWS PrivateLink connection.",
+			},
+			"last_status_change_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the last status change of the AWS PrivateLink connection.",
+			},
+			"ownership_role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The owner of the AWS PrivateLink connection.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The comment on the AWS PrivateLink connection.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func connectionAwsPrivatelinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*sqlx.DB)
+	o := materialize.MaterializeObject{
+		ObjectType:   "CONNECTION",
+		Name:         d.Get("name").(string),
+		SchemaName:   d.Get("schema_name").(string),
+		DatabaseName: d.Get("database_name").(string),
+	}
+
+	i, err := materialize.ConnectionId(conn, o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_available").(bool) {
+		if err := waitForPrivatelinkAvailable(ctx, conn, i, d.Timeout(schema.TimeoutRead)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	p, err := materialize.ScanConnectionAwsPrivatelink(conn, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	b := materialize.Connection{ConnectionName: p.ConnectionName.String, SchemaName: p.SchemaName.String, DatabaseName: p.DatabaseName.String}
+	if err := d.Set("qualified_sql_name", b.QualifiedName()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("principal", p.Principal.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("status", p.Status.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("last_status_change_at", p.LastStatusChangeAt.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("ownership_role", p.OwnerName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("comment", p.Comment.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(i)
+	return diags
+}
+
+// Polls mz_aws_privatelink_connection_statuses until the VPC endpoint is available
+func waitForPrivatelinkAvailable(ctx context.Context, conn *sqlx.DB, id string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		p, err := materialize.ScanConnectionAwsPrivatelink(conn, id)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		status := p.Status.String
+		if status == "available" {
+			return nil
+		}
+
+		if status == "" {
+			return retry.RetryableError(fmt.Errorf("connection %s has not reported a status yet", p.ConnectionName.String))
+		}
+
+		e := fmt.Errorf("connection %s is %s", p.ConnectionName.String, status)
+		for _, t := range privatelinkTerminalStatuses {
+			if status == t {
+				return retry.NonRetryableError(e)
+			}
+		}
+
+		return retry.RetryableError(e)
+	})
+}
@@ -0,0 +1,47 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionAwsPrivatelinkDatasource(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":               "connection",
+		"schema_name":        "schema",
+		"database_name":      "database",
+		"wait_for_available": true,
+	}
+	d := schema.TestResourceDataRaw(t, ConnectionAwsPrivatelink().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		ip := `WHERE mz_connections.name = 'connection' AND mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockConnectionScan(mock, ip)
+
+		// Wait for available
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionAwsPrivatelinkScan(mock, pp)
+
+		// Query Params
+		testhelpers.MockConnectionAwsPrivatelinkScan(mock, pp)
+
+		if err := connectionAwsPrivatelinkRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("u1", d.Id())
+		r.Equal("principal", d.Get("principal"))
+		r.Equal("available", d.Get("status"))
+		r.Equal(`"database"."schema"."connection"`, d.Get("qualified_sql_name"))
+	})
+}
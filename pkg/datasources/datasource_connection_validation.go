@@ -0,0 +1,103 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+func ConnectionValidation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: connectionValidationRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the connection.",
+			},
+			"schema_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "public",
+				Description: "The schema of the connection.",
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MZ_DATABASE", "materialize"),
+				Description: "The database of the connection. Defaults to `MZ_DATABASE` environment variable if set or `materialize` if environment variable is not set.",
+			},
+			"fail_on_error": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail the plan with the validation error when the connection is not valid instead of setting `valid` to `false`.",
+			},
+			"qualified_sql_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fully qualified name of the connection.",
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Materialize could reach the upstream system with the connection.",
+			},
+			"error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The error reported by Materialize if the validation failed.",
+			},
+		},
+	}
+}
+
+func connectionValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*sqlx.DB)
+	o := materialize.MaterializeObject{
+		ObjectType:   "CONNECTION",
+		Name:         d.Get("name").(string),
+		SchemaName:   d.Get("schema_name").(string),
+		DatabaseName: d.Get("database_name").(string),
+	}
+
+	i, err := materialize.ConnectionId(conn, o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	b := materialize.NewConnection(conn, o)
+	if err := d.Set("qualified_sql_name", b.QualifiedName()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only failures reported by the upstream system mark the connection as not
+	// valid, errors running the validation are returned as is
+	var validationError string
+	if err := b.ValidateConnection(); materialize.ConnectionValidationFailed(err) {
+		if d.Get("fail_on_error").(bool) {
+			return diag.FromErr(fmt.Errorf("connection %s is not valid: %w", b.QualifiedName(), err))
+		}
+		validationError = err.Error()
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("valid", validationError == ""); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("error", validationError); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(i)
+	return diags
+}
@@ -0,0 +1,101 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inConnectionValidation = map[string]interface{}{
+	"name":          "connection",
+	"schema_name":   "schema",
+	"database_name": "database",
+}
+
+func TestConnectionValidationDatasource(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ConnectionValidation().Schema, inConnectionValidation)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		ip := `WHERE mz_connections.name = 'connection' AND mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockConnectionScan(mock, ip)
+
+		mock.ExpectExec(`VALIDATE CONNECTION "database"."schema"."connection";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := connectionValidationRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("u1", d.Id())
+		r.Equal(true, d.Get("valid"))
+		r.Equal("", d.Get("error"))
+		r.Equal(`"database"."schema"."connection"`, d.Get("qualified_sql_name"))
+	})
+}
+
+func TestConnectionValidationDatasourceInvalid(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ConnectionValidation().Schema, inConnectionValidation)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		ip := `WHERE mz_connections.name = 'connection' AND mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockConnectionScan(mock, ip)
+
+		mock.ExpectExec(`VALIDATE CONNECTION "database"."schema"."connection";`).WillReturnError(pgx.PgError{Severity: "ERROR", Code: "XX000", Message: "password authentication failed"})
+
+		if err := connectionValidationRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("u1", d.Id())
+		r.Equal(false, d.Get("valid"))
+		r.Equal("ERROR: password authentication failed (SQLSTATE XX000)", d.Get("error"))
+	})
+}
+
+func TestConnectionValidationDatasourceFailOnError(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":          "connection",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"fail_on_error": true,
+	}
+	d := schema.TestResourceDataRaw(t, ConnectionValidation().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		ip := `WHERE mz_connections.name = 'connection' AND mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockConnectionScan(mock, ip)
+
+		mock.ExpectExec(`VALIDATE CONNECTION "database"."schema"."connection";`).WillReturnError(pgx.PgError{Severity: "ERROR", Code: "XX000", Message: "password authentication failed"})
+
+		r.NotNil(connectionValidationRead(context.TODO(), d, db))
+	})
+}
+
+func TestConnectionValidationDatasourceError(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ConnectionValidation().Schema, inConnectionValidation)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		ip := `WHERE mz_connections.name = 'connection' AND mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockConnectionScan(mock, ip)
+
+		mock.ExpectExec(`VALIDATE CONNECTION "database"."schema"."connection";`).WillReturnError(pgx.PgError{Severity: "ERROR", Code: "42501", Message: "permission denied for CONNECTION"})
+
+		r.NotNil(connectionValidationRead(context.TODO(), d, db))
+		r.Equal("", d.Id())
+	})
+}
@@ -3,6 +3,8 @@ package datasources
 import (
 	"context"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmoiron/sqlx"
@@ -24,10 +26,14 @@ func currentDatabaseRead(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 
 	conn := meta.(*sqlx.DB)
-	var name string
-	conn.QueryRow("SHOW DATABASE;").Scan(&name)
+	name, err := materialize.CurrentDatabase(conn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	d.Set("name", name)
+	if err := d.Set("name", name); err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId("current_database")
 
 	return diags
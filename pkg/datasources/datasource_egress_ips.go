@@ -2,8 +2,6 @@ package datasources
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 	"log"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
@@ -31,30 +29,8 @@ func EgressIpsRead(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 	conn := meta.(*sqlx.DB)
 
-	q := materialize.ReadEgressIpsDatasource()
-
-	rows, err := conn.Query(q)
-
-	if errors.Is(err, sql.ErrNoRows) {
-		log.Printf("[DEBUG] no egress IPs found in account")
-		return diag.FromErr(err)
-	} else if err != nil {
-		log.Println("[DEBUG] failed to list egress IPs")
-		return diag.FromErr(err)
-	}
-
-	egressIps := []string{}
-	for rows.Next() {
-		var egressIp string
-		err := rows.Scan(&egressIp)
-		if err != nil {
-			log.Println("[DEBUG] failed to scan egress IP")
-			return diag.FromErr(err)
-		}
-		egressIps = append(egressIps, egressIp)
-	}
-
-	if err := rows.Err(); err != nil {
+	egressIps, err := materialize.ListEgressIps(conn)
+	if err != nil {
 		log.Println("[DEBUG] failed to list egress IPs")
 		return diag.FromErr(err)
 	}
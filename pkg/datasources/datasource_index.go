@@ -25,6 +25,8 @@ func Index() *schema.Resource {
 				Description:  "Limit indexes to a specific schema within a specific database",
 				RequiredWith: []string{"database_name"},
 			},
+			"name_pattern": NamePatternSchema("indexes"),
+			"names":        NamesSchema("indexes"),
 			"indexes": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -64,7 +66,7 @@ func indexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 
 	var diags diag.Diagnostics
 
-	dataSource, err := materialize.ListIndexes(meta.(*sqlx.DB), schemaName, databaseName)
+	dataSource, err := materialize.ListIndexes(meta.(*sqlx.DB), schemaName, databaseName, NamePredicates("mz_indexes.name", d)...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -25,6 +25,8 @@ func MaterializedView() *schema.Resource {
 				Description:  "Limit materialized views to a specific schema within a specific database",
 				RequiredWith: []string{"database_name"},
 			},
+			"name_pattern": NamePatternSchema("materialized views"),
+			"names":        NamesSchema("materialized views"),
 			"materialized_views": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -60,7 +62,7 @@ func materializedViewRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	var diags diag.Diagnostics
 
-	dataSource, err := materialize.ListMaterializedViews(meta.(*sqlx.DB), schemaName, databaseName)
+	dataSource, err := materialize.ListMaterializedViews(meta.(*sqlx.DB), schemaName, databaseName, NamePredicates("mz_materialized_views.name", d)...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,113 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+func NetworkPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: networkPolicyRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the network policy. Defaults to the network policy active in the region.",
+			},
+			"ownership_role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The owner of the network policy.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rules of the network policy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direction": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func networkPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*sqlx.DB)
+
+	name := d.Get("name").(string)
+	if name == "" {
+		n, err := materialize.ActiveNetworkPolicyName(conn)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		name = n
+	}
+
+	o := materialize.MaterializeObject{ObjectType: "NETWORK POLICY", Name: name}
+	i, err := materialize.NetworkPolicyId(conn, o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	p, err := materialize.ScanNetworkPolicy(conn, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataSource, err := materialize.ListNetworkPolicyRules(conn, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleFormats := []map[string]interface{}{}
+	for _, r := range dataSource {
+		ruleMap := map[string]interface{}{}
+
+		ruleMap["name"] = r.Name.String
+		ruleMap["action"] = r.Action.String
+		ruleMap["direction"] = r.Direction.String
+		ruleMap["address"] = r.Address.String
+
+		ruleFormats = append(ruleFormats, ruleMap)
+	}
+
+	if err := d.Set("name", p.NetworkPolicyName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("ownership_role", p.OwnerName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("rules", ruleFormats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(i)
+	return diags
+}
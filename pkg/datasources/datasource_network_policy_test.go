@@ -0,0 +1,38 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkPolicyDatasource(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{}
+	d := schema.TestResourceDataRaw(t, NetworkPolicy().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		ir := mock.NewRows([]string{"network_policy"}).AddRow("office_access_policy")
+		mock.ExpectQuery(`SHOW network_policy;`).WillReturnRows(ir)
+
+		testhelpers.MockNetworkPolicyScan(mock, `WHERE mz_network_policies.name = 'office_access_policy'`)
+		testhelpers.MockNetworkPolicyScan(mock, `WHERE mz_network_policies.id = 'u1'`)
+		testhelpers.MockNetworkPolicyRuleScan(mock, `WHERE mz_network_policy_rules.policy_id = 'u1'`)
+
+		if err := networkPolicyRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("office_access_policy", d.Get("name"))
+		r.Equal("minnesota", d.Get("rules.0.name"))
+		r.Equal("1.2.3.4/28", d.Get("rules.1.address"))
+	})
+}
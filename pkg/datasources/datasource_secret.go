@@ -25,6 +25,8 @@ func Secret() *schema.Resource {
 				Description:  "Limit secrets to a specific schema within a specific database",
 				RequiredWith: []string{"database_name"},
 			},
+			"name_pattern": NamePatternSchema("secrets"),
+			"names":        NamesSchema("secrets"),
 			"secrets": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -60,7 +62,7 @@ func secretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	var diags diag.Diagnostics
 
-	dataSource, err := materialize.ListSecrets(meta.(*sqlx.DB), schemaName, databaseName)
+	dataSource, err := materialize.ListSecrets(meta.(*sqlx.DB), schemaName, databaseName, NamePredicates("mz_secrets.name", d)...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -25,6 +25,8 @@ func Sink() *schema.Resource {
 				Description:  "Limit sinks to a specific schema within a specific database",
 				RequiredWith: []string{"database_name"},
 			},
+			"name_pattern": NamePatternSchema("sinks"),
+			"names":        NamesSchema("sinks"),
 			"sinks": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -80,7 +82,7 @@ func sinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 
 	var diags diag.Diagnostics
 
-	dataSource, err := materialize.ListSinks(meta.(*sqlx.DB), schemaName, databaseName)
+	dataSource, err := materialize.ListSinks(meta.(*sqlx.DB), schemaName, databaseName, NamePredicates("mz_sinks.name", d)...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -25,6 +25,8 @@ func Source() *schema.Resource {
 				Description:  "Limit sources to a specific schema within a specific database",
 				RequiredWith: []string{"database_name"},
 			},
+			"name_pattern": NamePatternSchema("sources"),
+			"names":        NamesSchema("sources"),
 			"sources": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -80,7 +82,7 @@ func sourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	var diags diag.Diagnostics
 
-	dataSource, err := materialize.ListSources(meta.(*sqlx.DB), schemaName, databaseName)
+	dataSource, err := materialize.ListSources(meta.(*sqlx.DB), schemaName, databaseName, NamePredicates("mz_sources.name", d)...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,117 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+func SourceStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: sourceStatusRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limit statuses to sources with a specific name",
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limit statuses to sources in a specific database",
+			},
+			"schema_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Limit statuses to sources in a specific schema within a specific database",
+				RequiredWith: []string{"database_name"},
+			},
+			"statuses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status of the sources in the account",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_status_change_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func sourceStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sourceName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	var diags diag.Diagnostics
+
+	dataSource, err := materialize.ListSourceStatuses(meta.(*sqlx.DB), sourceName, schemaName, databaseName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusFormats := []map[string]interface{}{}
+	for _, p := range dataSource {
+		statusMap := map[string]interface{}{}
+
+		statusMap["id"] = p.ObjectId.String
+		statusMap["name"] = p.ObjectName.String
+		statusMap["schema_name"] = p.SchemaName.String
+		statusMap["database_name"] = p.DatabaseName.String
+		statusMap["type"] = p.Type.String
+		statusMap["status"] = p.Status.String
+		statusMap["error"] = p.Error.String
+		statusMap["last_status_change_at"] = p.LastStatusChangeAt.String
+
+		statusFormats = append(statusFormats, statusMap)
+	}
+
+	if err := d.Set("statuses", statusFormats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resource := "source_statuses"
+	if sourceName != "" {
+		resource = sourceName + "|" + resource
+	}
+	SetId(resource, databaseName, schemaName, d)
+
+	return diags
+}
@@ -0,0 +1,39 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceStatusDatasource(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":          "source",
+		"schema_name":   "schema",
+		"database_name": "database",
+	}
+	d := schema.TestResourceDataRaw(t, SourceStatus().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_source_statuses.name = 'source'`
+		testhelpers.MockSourceStatusListScan(mock, p, "stalled", "kafka: broker unavailable")
+
+		if err := sourceStatusRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		s := d.Get("statuses").([]interface{})[0].(map[string]interface{})
+		r.Equal("stalled", s["status"])
+		r.Equal("kafka: broker unavailable", s["error"])
+		r.Equal("database|schema|source|source_statuses", d.Id())
+	})
+}
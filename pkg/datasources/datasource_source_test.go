@@ -32,3 +32,24 @@ func TestSourceDatasource(t *testing.T) {
 	})
 
 }
+
+func TestSourceDatasourceNameFilters(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database_name": "database",
+		"name_pattern":  "prod_%",
+		"names":         []interface{}{"prod_a", "prod_b"},
+	}
+	d := schema.TestResourceDataRaw(t, Source().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_databases.name = 'database' AND mz_sources.name IN \('prod_a', 'prod_b'\) AND mz_sources.name LIKE 'prod_%'`
+		testhelpers.MockSourceScan(mock, p)
+
+		if err := sourceRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
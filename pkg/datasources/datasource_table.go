@@ -25,6 +25,8 @@ func Table() *schema.Resource {
 				Description:  "Limit tables to a specific schema within a specific database",
 				RequiredWith: []string{"database_name"},
 			},
+			"name_pattern": NamePatternSchema("tables"),
+			"names":        NamesSchema("tables"),
 			"tables": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -60,7 +62,7 @@ func tableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 
 	var diags diag.Diagnostics
 
-	dataSource, err := materialize.ListTables(meta.(*sqlx.DB), schemaName, databaseName)
+	dataSource, err := materialize.ListTables(meta.(*sqlx.DB), schemaName, databaseName, NamePredicates("mz_tables.name", d)...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
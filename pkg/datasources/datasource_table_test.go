@@ -32,3 +32,24 @@ func TestTableDatasource(t *testing.T) {
 	})
 
 }
+
+func TestTableDatasourceNameFilters(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database_name": "database",
+		"name_pattern":  "prod_%",
+		"names":         []interface{}{"prod_a", "prod_b"},
+	}
+	d := schema.TestResourceDataRaw(t, Table().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_databases.name = 'database' AND mz_tables.name IN \('prod_a', 'prod_b'\) AND mz_tables.name LIKE 'prod_%'`
+		testhelpers.MockTableScan(mock, p)
+
+		if err := tableRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -25,6 +25,8 @@ func Type() *schema.Resource {
 				Description:  "Limit types to a specific schema within a specific database",
 				RequiredWith: []string{"database_name"},
 			},
+			"name_pattern": NamePatternSchema("types"),
+			"names":        NamesSchema("types"),
 			"types": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -64,7 +66,7 @@ func typeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 
 	var diags diag.Diagnostics
 
-	dataSource, err := materialize.ListTypes(meta.(*sqlx.DB), schemaName, databaseName)
+	dataSource, err := materialize.ListTypes(meta.(*sqlx.DB), schemaName, databaseName, NamePredicates("mz_types.name", d)...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -25,6 +25,8 @@ func View() *schema.Resource {
 				Description:  "Limit views to a specific schema within a specific database",
 				RequiredWith: []string{"database_name"},
 			},
+			"name_pattern": NamePatternSchema("views"),
+			"names":        NamesSchema("views"),
 			"views": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -60,7 +62,7 @@ func viewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 
 	var diags diag.Diagnostics
 
-	dataSource, err := materialize.ListViews(meta.(*sqlx.DB), schemaName, databaseName)
+	dataSource, err := materialize.ListViews(meta.(*sqlx.DB), schemaName, databaseName, NamePredicates("mz_views.name", d)...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
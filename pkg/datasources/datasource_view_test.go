@@ -32,3 +32,24 @@ func TestViewDatasource(t *testing.T) {
 	})
 
 }
+
+func TestViewDatasourceNameFilters(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database_name": "database",
+		"name_pattern":  "prod_%",
+		"names":         []interface{}{"prod_a", "prod_b"},
+	}
+	d := schema.TestResourceDataRaw(t, View().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_databases.name = 'database' AND mz_views.name IN \('prod_a', 'prod_b'\) AND mz_views.name LIKE 'prod_%'`
+		testhelpers.MockViewScan(mock, p)
+
+		if err := viewRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -3,6 +3,8 @@ package datasources
 import (
 	"fmt"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,3 +20,37 @@ func SetId(resource, databaseName, schemaName string, d *schema.ResourceData) {
 
 	d.SetId(id)
 }
+
+func NamePatternSchema(objects string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: fmt.Sprintf("Limit %s to names matching a SQL `LIKE` pattern, e.g. `prod_%%`", objects),
+	}
+}
+
+func NamesSchema(objects string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: fmt.Sprintf("Limit %s to the given names", objects),
+	}
+}
+
+// Returns the predicates for the name filters of the data source on column
+func NamePredicates(column string, d *schema.ResourceData) []materialize.Predicate {
+	var p []materialize.Predicate
+	if v, ok := d.GetOk("name_pattern"); ok {
+		p = append(p, materialize.Like(column, v.(string)))
+	}
+
+	if v, ok := d.GetOk("names"); ok {
+		var names []string
+		for _, n := range v.([]interface{}) {
+			names = append(names, n.(string))
+		}
+		p = append(p, materialize.In(column, names...))
+	}
+	return p
+}
@@ -0,0 +1,668 @@
+package exporter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/resources"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Connections, sources and sinks are exported from their `create_sql`. The
+// provider does not read most of their attributes back so the attributes are
+// ignored after the import, the configuration only describes how to create
+// the object
+
+// The resource of a connection, source or sink type and the attributes that
+// its read sets besides the name, namespace, cluster, ownership and comment
+type definitionResource struct {
+	resourceType string
+	resource     func() *schema.Resource
+	read         []string
+}
+
+var connectionResources = map[string]definitionResource{
+	"kafka":                     {"materialize_connection_kafka", resources.ConnectionKafka, nil},
+	"confluent schema registry": {"materialize_connection_confluent_schema_registry", resources.ConnectionConfluentSchemaRegistry, nil},
+	"postgres":                  {"materialize_connection_postgres", resources.ConnectionPostgres, nil},
+	"mysql":                     {"materialize_connection_mysql", resources.ConnectionMysql, nil},
+	"ssh tunnel":                {"materialize_connection_ssh_tunnel", resources.ConnectionSshTunnel, nil},
+	"aws privatelink":           {"materialize_connection_aws_privatelink", resources.ConnectionAwsPrivatelink, nil},
+	"aws":                       {"materialize_connection_aws", resources.ConnectionAws, []string{"endpoint", "region", "assume_role_arn", "assume_role_session_name"}},
+}
+
+var sourceResources = map[string]definitionResource{
+	"kafka":          {"materialize_source_kafka", resources.SourceKafka, nil},
+	"postgres":       {"materialize_source_postgres", resources.SourcePostgres, nil},
+	"mysql":          {"materialize_source_mysql", resources.SourceMysql, nil},
+	"load generator": {"materialize_source_load_generator", resources.SourceLoadgen, nil},
+	"webhook":        {"materialize_source_webhook", resources.SourceWebhook, nil},
+}
+
+var sinkResource = definitionResource{"materialize_sink_kafka", resources.SinkKafka, []string{"topic"}}
+
+// Options that Materialize adds to a definition. They are derived from the
+// other options or, for the size of a source, read from the catalog
+var derivedOptions = map[string]bool{
+	"details":           true,
+	"security protocol": true,
+	"size":              true,
+}
+
+// Options with a different attribute name than the option name
+var renamedOptions = map[string]string{
+	"exclude columns": "ignore_columns",
+}
+
+func (e *Exporter) exportConnections(database, schema string) error {
+	connections, err := materialize.ListConnections(e.conn, schema, database)
+	if err != nil {
+		return err
+	}
+	sort.Slice(connections, func(i, j int) bool {
+		return connections[i].ConnectionName.String < connections[j].ConnectionName.String
+	})
+
+	for _, c := range connections {
+		if !userObject(c.ConnectionId.String) {
+			continue
+		}
+
+		name := c.ConnectionName.String
+		qualified := materialize.QualifiedName(database, schema, name)
+
+		d, err := materialize.ParseConnectionDefinition(c.CreateSql.String)
+		if err != nil {
+			e.skip("connection", qualified, err.Error())
+			continue
+		}
+
+		r, ok := connectionResources[d.Type]
+		if !ok {
+			e.skip("connection", qualified, fmt.Sprintf("%s connections are not supported by the provider", strings.ToUpper(d.Type)))
+			continue
+		}
+
+		blk := hclwrite.NewBlock("resource", nil)
+		b := blk.Body()
+		setString(b, "name", name)
+		e.setNamespace(b, database, schema)
+		e.setName(b, "ownership_role", roleKey(c.OwnerName.String), c.OwnerName.String)
+		setOptionalString(b, "comment", c.Comment.String)
+
+		if err := e.setConnection(b, r.resource(), d); err != nil {
+			e.skip("connection", qualified, err.Error())
+			continue
+		}
+		ignoreUnread(b, r)
+
+		address := e.addResource(objectFile, blk, r.resourceType, c.ConnectionId.String, database, schema, name)
+		e.addresses[objectKey(database, schema, name)] = address
+
+		e.grants("CONNECTION", "materialize_connection_grant", c.ConnectionId.String, c.OwnerName.String, c.Privileges.String, address, []string{database, schema, name}, namespacedGrantAttributes("connection_name"))
+	}
+
+	return nil
+}
+
+func (e *Exporter) setConnection(b *hclwrite.Body, r *schema.Resource, d materialize.ConnectionDefinition) error {
+	for _, k := range d.Brokers {
+		kb := b.AppendNewBlock("kafka_broker", nil).Body()
+		setString(kb, "broker", k.Broker)
+		if k.TargetGroupPort != 0 {
+			kb.SetAttributeValue("target_group_port", cty.NumberIntVal(int64(k.TargetGroupPort)))
+		}
+		setOptionalString(kb, "availability_zone", k.AvailabilityZone)
+		if k.PrivateLinkConnection.Name != "" {
+			e.setIdentifier(kb, "privatelink_connection", k.PrivateLinkConnection)
+		}
+	}
+
+	if err := e.setOptions(b, r.Schema, d.Options); err != nil {
+		return err
+	}
+	return e.setOptions(b, r.Schema, d.With)
+}
+
+func (e *Exporter) exportSources(database, schema string) error {
+	sources, err := materialize.ListSources(e.conn, schema, database)
+	if err != nil {
+		return err
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].SourceName.String < sources[j].SourceName.String })
+
+	for _, s := range sources {
+		// Progress collections and subsources are managed by their source
+		if !userObject(s.SourceId.String) || s.SourceType.String == "progress" || s.SourceType.String == "subsource" {
+			continue
+		}
+
+		name := s.SourceName.String
+		qualified := materialize.QualifiedName(database, schema, name)
+
+		d, err := materialize.ParseSourceDefinition(s.CreateSql.String)
+		if err != nil {
+			e.skip("source", qualified, err.Error())
+			continue
+		}
+
+		r, ok := sourceResources[d.Type]
+		if !ok {
+			e.skip("source", qualified, fmt.Sprintf("%s sources are not supported by the provider", strings.ToUpper(d.Type)))
+			continue
+		}
+
+		// Tables created as subsources are not part of the definition
+		if (d.Type == "postgres" || d.Type == "mysql") && len(d.Tables) == 0 && len(d.Schemas) == 0 {
+			if d.Tables, err = e.subsourceTables(s.SourceId.String, database, schema); err != nil {
+				return err
+			}
+		}
+
+		blk := hclwrite.NewBlock("resource", nil)
+		b := blk.Body()
+		setString(b, "name", name)
+		e.setNamespace(b, database, schema)
+		if s.ClusterName.String != "" {
+			e.setName(b, "cluster_name", clusterKey(s.ClusterName.String), s.ClusterName.String)
+		}
+		e.setName(b, "ownership_role", roleKey(s.OwnerName.String), s.OwnerName.String)
+		setOptionalString(b, "comment", s.Comment.String)
+
+		if err := e.setSource(b, r.resource(), d); err != nil {
+			e.skip("source", qualified, err.Error())
+			continue
+		}
+		ignoreUnread(b, r)
+
+		address := e.addResource(objectFile, blk, r.resourceType, s.SourceId.String, database, schema, name)
+		e.addresses[objectKey(database, schema, name)] = address
+
+		e.grants("SOURCE", "materialize_source_grant", s.SourceId.String, s.OwnerName.String, s.Privileges.String, address, []string{database, schema, name}, namespacedGrantAttributes("source_name"))
+	}
+
+	return nil
+}
+
+// Returns the upstream tables of the subsources of a source. Subsources in
+// another schema than the source are aliased by their qualified name
+func (e *Exporter) subsourceTables(sourceId, database, schema string) ([]materialize.TableStruct, error) {
+	deps, err := materialize.ListDependents(e.conn, sourceId)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(deps, func(i, j int) bool { return deps[i].ObjectName.String < deps[j].ObjectName.String })
+
+	var tables []materialize.TableStruct
+	for _, dep := range deps {
+		if dep.Type.String != "source" {
+			continue
+		}
+
+		s, err := materialize.ScanSource(e.conn, dep.ObjectId.String)
+		if err != nil {
+			return nil, err
+		}
+		if s.SourceType.String != "subsource" {
+			continue
+		}
+
+		d, err := materialize.ParseSubsourceDefinition(s.CreateSql.String)
+		if err != nil || len(d.ExternalReference) < 2 {
+			continue
+		}
+
+		// Postgres references include the upstream database
+		r := d.ExternalReference[len(d.ExternalReference)-2:]
+		t := materialize.TableStruct{Name: strings.Join(r, "."), Alias: dep.ObjectName.String}
+		if dep.DatabaseName.String != database || dep.SchemaName.String != schema {
+			t.Alias = strings.Join([]string{dep.DatabaseName.String, dep.SchemaName.String, dep.ObjectName.String}, ".")
+		}
+		tables = append(tables, t)
+	}
+
+	return tables, nil
+}
+
+func (e *Exporter) setSource(b *hclwrite.Body, r *schema.Resource, d materialize.SourceDefinition) error {
+	s := r.Schema
+
+	switch d.Type {
+	case "load generator":
+		setString(b, "load_generator_type", strings.ToUpper(d.LoadGenerator))
+		if len(d.Options) > 0 {
+			name := strings.ReplaceAll(d.LoadGenerator, " ", "_") + "_options"
+			o, ok := s[name]
+			if !ok {
+				return fmt.Errorf("the options of %s load generators are not supported by the provider", strings.ToUpper(d.LoadGenerator))
+			}
+			ob := b.AppendNewBlock(name, nil).Body()
+			if err := e.setOptions(ob, o.Elem.(*schema.Resource).Schema, d.Options); err != nil {
+				return err
+			}
+		}
+
+	case "webhook":
+		setString(b, "body_format", d.BodyFormat)
+		if err := e.setWebhook(b, d); err != nil {
+			return err
+		}
+
+	default:
+		e.setIdentifier(b, d.Type+"_connection", d.Connection)
+		if err := e.setOptions(b, s, d.Options); err != nil {
+			return err
+		}
+
+		for _, t := range d.Tables {
+			tb := b.AppendNewBlock("table", nil).Body()
+			setString(tb, "name", t.Name)
+			setOptionalString(tb, "alias", t.Alias)
+		}
+		setStrings(b, "schema", d.Schemas)
+
+		e.setSourceFormat(b, "format", d.Format)
+		e.setSourceFormat(b, "key_format", d.KeyFormat)
+		e.setSourceFormat(b, "value_format", d.ValueFormat)
+
+		if err := setIncludes(b, s, d); err != nil {
+			return err
+		}
+
+		if d.Envelope.Debezium || d.Envelope.None || d.Envelope.Upsert {
+			eb := b.AppendNewBlock("envelope", nil).Body()
+			setTrue(eb, "debezium", d.Envelope.Debezium)
+			setTrue(eb, "none", d.Envelope.None)
+			setTrue(eb, "upsert", d.Envelope.Upsert)
+		}
+	}
+
+	if _, ok := s["expose_progress"]; ok {
+		setOptionalString(b, "expose_progress", d.ExposeProgress.Name)
+	}
+
+	return e.setOptions(b, s, d.With)
+}
+
+// Sets the metadata that Kafka sources include, each item has an include
+// attribute and an alias attribute
+func setIncludes(b *hclwrite.Body, s map[string]*schema.Schema, d materialize.SourceDefinition) error {
+	if len(d.IncludeHeader) > 0 || len(d.IncludeHeaders.Only) > 0 || len(d.IncludeHeaders.Not) > 0 {
+		return fmt.Errorf("INCLUDE HEADER is not supported by the provider for %s sources", strings.ToUpper(d.Type))
+	}
+
+	var items []string
+	for i := range d.Include {
+		items = append(items, i)
+	}
+	sort.Strings(items)
+
+	for _, i := range items {
+		if _, ok := s["include_"+i]; !ok {
+			return fmt.Errorf("INCLUDE %s is not supported by the provider", strings.ToUpper(i))
+		}
+		b.SetAttributeValue("include_"+i, cty.True)
+		setOptionalString(b, "include_"+i+"_alias", d.Include[i])
+	}
+	return nil
+}
+
+func (e *Exporter) setWebhook(b *hclwrite.Body, d materialize.SourceDefinition) error {
+	for i := range d.Include {
+		if i != "headers" {
+			return fmt.Errorf("INCLUDE %s is not supported by the provider for WEBHOOK sources", strings.ToUpper(i))
+		}
+	}
+
+	for _, h := range d.IncludeHeader {
+		hb := b.AppendNewBlock("include_header", nil).Body()
+		setString(hb, "header", h.Header)
+		setOptionalString(hb, "alias", h.Alias)
+		setTrue(hb, "bytes", h.Bytes)
+	}
+
+	if _, ok := d.Include["headers"]; ok {
+		hb := b.AppendNewBlock("include_headers", nil).Body()
+		setTrue(hb, "all", d.IncludeHeaders.All)
+		setStrings(hb, "only", d.IncludeHeaders.Only)
+		setStrings(hb, "not", d.IncludeHeaders.Not)
+	}
+
+	for _, c := range d.CheckOptions {
+		ob := b.AppendNewBlock("check_options", nil).Body()
+		fb := ob.AppendNewBlock("field", nil).Body()
+		setTrue(fb, "body", c.Field.Body)
+		setTrue(fb, "headers", c.Field.Headers)
+		if c.Field.Secret.Name != "" {
+			e.setIdentifier(fb, "secret", c.Field.Secret)
+		}
+		setOptionalString(ob, "alias", c.Alias)
+		setTrue(ob, "bytes", c.Bytes)
+	}
+
+	setOptionalString(b, "check_expression", d.CheckExpression)
+	return nil
+}
+
+func (e *Exporter) setSourceFormat(b *hclwrite.Body, name string, f materialize.SourceFormatSpecStruct) {
+	if f.Avro == nil && f.Protobuf == nil && f.Csv == nil && !f.Bytes && !f.Text && !f.Json {
+		return
+	}
+
+	fb := b.AppendNewBlock(name, nil).Body()
+	switch {
+	case f.Avro != nil:
+		ab := fb.AppendNewBlock("avro", nil).Body()
+		e.setIdentifier(ab, "schema_registry_connection", f.Avro.SchemaRegistryConnection)
+		setOptionalString(ab, "key_strategy", f.Avro.KeyStrategy)
+		setOptionalString(ab, "value_strategy", f.Avro.ValueStrategy)
+
+	case f.Protobuf != nil:
+		pb := fb.AppendNewBlock("protobuf", nil).Body()
+		e.setIdentifier(pb, "schema_registry_connection", f.Protobuf.SchemaRegistryConnection)
+		setString(pb, "message", f.Protobuf.MessageName)
+
+	case f.Csv != nil:
+		cb := fb.AppendNewBlock("csv", nil).Body()
+		if f.Csv.Columns != 0 {
+			cb.SetAttributeValue("column", cty.NumberIntVal(int64(f.Csv.Columns)))
+		}
+		setOptionalString(cb, "delimited_by", f.Csv.DelimitedBy)
+		setStrings(cb, "header", f.Csv.Header)
+	}
+
+	setTrue(fb, "bytes", f.Bytes)
+	setTrue(fb, "text", f.Text)
+	setTrue(fb, "json", f.Json)
+}
+
+func (e *Exporter) exportSinks(database, schema string) error {
+	sinks, err := materialize.ListSinks(e.conn, schema, database)
+	if err != nil {
+		return err
+	}
+	sort.Slice(sinks, func(i, j int) bool { return sinks[i].SinkName.String < sinks[j].SinkName.String })
+
+	for _, s := range sinks {
+		if !userObject(s.SinkId.String) {
+			continue
+		}
+
+		name := s.SinkName.String
+		qualified := materialize.QualifiedName(database, schema, name)
+
+		if s.SinkType.String != "kafka" {
+			e.skip("sink", qualified, fmt.Sprintf("%s sinks are not supported by the provider", strings.ToUpper(s.SinkType.String)))
+			continue
+		}
+
+		d, err := materialize.ParseSinkDefinition(s.CreateSql.String)
+		if err != nil {
+			e.skip("sink", qualified, err.Error())
+			continue
+		}
+
+		blk := hclwrite.NewBlock("resource", nil)
+		b := blk.Body()
+		setString(b, "name", name)
+		e.setNamespace(b, database, schema)
+		if s.ClusterName.String != "" {
+			e.setName(b, "cluster_name", clusterKey(s.ClusterName.String), s.ClusterName.String)
+		}
+		e.setName(b, "ownership_role", roleKey(s.OwnerName.String), s.OwnerName.String)
+		setOptionalString(b, "comment", s.Comment.String)
+
+		if err := e.setSink(b, sinkResource.resource(), d); err != nil {
+			e.skip("sink", qualified, err.Error())
+			continue
+		}
+		ignoreUnread(b, sinkResource)
+
+		e.addResource(objectFile, blk, sinkResource.resourceType, s.SinkId.String, database, schema, name)
+	}
+
+	return nil
+}
+
+func (e *Exporter) setSink(b *hclwrite.Body, r *schema.Resource, d materialize.SinkDefinition) error {
+	e.setIdentifier(b, "from", d.From)
+	e.setIdentifier(b, "kafka_connection", d.Connection)
+	if err := e.setOptions(b, r.Schema, d.Options); err != nil {
+		return err
+	}
+
+	if len(d.TopicConfig) > 0 {
+		c := map[string]cty.Value{}
+		for k, v := range d.TopicConfig {
+			c[k] = cty.StringVal(v)
+		}
+		b.SetAttributeValue("topic_config", cty.MapVal(c))
+	}
+
+	setStrings(b, "key", d.Key)
+	setTrue(b, "key_not_enforced", d.KeyNotEnforced)
+	setOptionalString(b, "headers", d.Headers)
+
+	e.setSinkFormat(b, "format", d.Format)
+	e.setSinkFormat(b, "key_format", d.KeyFormat)
+	e.setSinkFormat(b, "value_format", d.ValueFormat)
+
+	if d.Envelope.Debezium || d.Envelope.Upsert {
+		eb := b.AppendNewBlock("envelope", nil).Body()
+		setTrue(eb, "debezium", d.Envelope.Debezium)
+		setTrue(eb, "upsert", d.Envelope.Upsert)
+	}
+
+	return e.setOptions(b, r.Schema, d.With)
+}
+
+func (e *Exporter) setSinkFormat(b *hclwrite.Body, name string, f materialize.SinkFormatSpecStruct) {
+	if f.Avro == nil && !f.Json && !f.Text && !f.Bytes {
+		return
+	}
+
+	fb := b.AppendNewBlock(name, nil).Body()
+	if a := f.Avro; a != nil {
+		ab := fb.AppendNewBlock("avro", nil).Body()
+		e.setIdentifier(ab, "schema_registry_connection", a.SchemaRegistryConnection)
+		setOptionalString(ab, "avro_key_fullname", a.AvroKeyFullname)
+		setOptionalString(ab, "avro_value_fullname", a.AvroValueFullname)
+
+		for _, d := range a.DocType {
+			db := ab.AppendNewBlock("avro_doc_type", nil).Body()
+			e.setIdentifier(db, "object", d.Object)
+			setString(db, "doc", d.Doc)
+			setTrue(db, "key", d.Key)
+			setTrue(db, "value", d.Value)
+		}
+
+		for _, d := range a.DocColumn {
+			db := ab.AppendNewBlock("avro_doc_column", nil).Body()
+			e.setIdentifier(db, "object", d.Object)
+			setString(db, "column", d.Column)
+			setString(db, "doc", d.Doc)
+			setTrue(db, "key", d.Key)
+			setTrue(db, "value", d.Value)
+		}
+	}
+
+	setTrue(fb, "json", f.Json)
+	setTrue(fb, "text", f.Text)
+	setTrue(fb, "bytes", f.Bytes)
+}
+
+// Sets the attribute of each option, named after the option with underscores.
+// Options without an attribute cannot be exported
+func (e *Exporter) setOptions(b *hclwrite.Body, s map[string]*schema.Schema, o materialize.Options) error {
+	var names []string
+	for n := range o {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	for _, n := range names {
+		if derivedOptions[n] {
+			continue
+		}
+
+		a := strings.ReplaceAll(n, " ", "_")
+		if r, ok := renamedOptions[n]; ok {
+			a = r
+		}
+
+		as, ok := s[a]
+		if !ok || !(as.Optional || as.Required) {
+			return fmt.Errorf("option %s is not supported by the provider", strings.ToUpper(n))
+		}
+
+		if err := e.setOption(b, a, as, o[n]); err != nil {
+			return fmt.Errorf("option %s: %s", strings.ToUpper(n), err)
+		}
+	}
+
+	return nil
+}
+
+func (e *Exporter) setOption(b *hclwrite.Body, name string, s *schema.Schema, v materialize.OptionValue) error {
+	if s.Type != schema.TypeList {
+		c, err := optionValue(s.Type, v)
+		if err != nil {
+			return err
+		}
+		b.SetAttributeValue(name, c)
+		return nil
+	}
+
+	switch elem := s.Elem.(type) {
+	// Blocks with either a text or a secret
+	case *schema.Resource:
+		if _, ok := elem.Schema["text"]; ok {
+			vb := b.AppendNewBlock(name, nil).Body()
+			if v.Secret {
+				e.setIdentifier(vb, "secret", v.Object)
+			} else {
+				setString(vb, "text", v.Text)
+			}
+			return nil
+		}
+
+		if v.Object.Name == "" {
+			return fmt.Errorf("expected an object")
+		}
+		e.setIdentifier(b, name, v.Object)
+
+	case *schema.Schema:
+		values := v.Values
+		if values == nil {
+			values = []materialize.OptionValue{v}
+		}
+
+		var l []cty.Value
+		for _, i := range values {
+			c, err := optionValue(elem.Type, i)
+			if err != nil {
+				return err
+			}
+			l = append(l, c)
+		}
+		if len(l) > 0 {
+			b.SetAttributeValue(name, cty.ListVal(l))
+		}
+	}
+
+	return nil
+}
+
+func optionValue(t schema.ValueType, v materialize.OptionValue) (cty.Value, error) {
+	switch t {
+	case schema.TypeInt:
+		i, err := strconv.ParseInt(v.Text, 10, 64)
+		return cty.NumberIntVal(i), err
+	case schema.TypeFloat:
+		f, err := strconv.ParseFloat(v.Text, 64)
+		return cty.NumberFloatVal(f), err
+	case schema.TypeBool:
+		b, err := strconv.ParseBool(v.Text)
+		return cty.BoolVal(b), err
+	case schema.TypeString:
+		if v.Object.Name != "" || v.Values != nil {
+			return cty.NilVal, fmt.Errorf("expected a value")
+		}
+		return cty.StringVal(v.Text), nil
+	}
+	return cty.NilVal, fmt.Errorf("unsupported attribute type %s", t)
+}
+
+// Adds a block that identifies an object by its name, schema and database,
+// referencing the resource of the object if it was exported
+func (e *Exporter) setIdentifier(b *hclwrite.Body, name string, o materialize.IdentifierSchemaStruct) {
+	ib := b.AppendNewBlock(name, nil).Body()
+	key := objectKey(o.DatabaseName, o.SchemaName, o.Name)
+	if a, ok := e.addresses[key]; ok {
+		ib.SetAttributeTraversal("name", traversal(a, "name"))
+		ib.SetAttributeTraversal("schema_name", traversal(a, "schema_name"))
+		ib.SetAttributeTraversal("database_name", traversal(a, "database_name"))
+		return
+	}
+	setString(ib, "name", o.Name)
+	setOptionalString(ib, "schema_name", o.SchemaName)
+	setOptionalString(ib, "database_name", o.DatabaseName)
+}
+
+func setStrings(b *hclwrite.Body, name string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	var l []cty.Value
+	for _, v := range values {
+		l = append(l, cty.StringVal(v))
+	}
+	b.SetAttributeValue(name, cty.ListVal(l))
+}
+
+// Sets a boolean attribute that defaults to false
+func setTrue(b *hclwrite.Body, name string, value bool) {
+	if value {
+		b.SetAttributeValue(name, cty.True)
+	}
+}
+
+// Ignores the configurable attributes that the provider does not read back,
+// including attributes with a default that are not in the configuration.
+// The imported object has no value for them and would otherwise be replaced
+func ignoreUnread(b *hclwrite.Body, r definitionResource) {
+	read := map[string]bool{
+		"name":           true,
+		"schema_name":    true,
+		"database_name":  true,
+		"cluster_name":   true,
+		"ownership_role": true,
+		"comment":        true,
+	}
+	for _, a := range r.read {
+		read[a] = true
+	}
+
+	var ignored []string
+	for k, s := range r.resource().Schema {
+		if (s.Optional || s.Required) && !s.Computed && !read[k] {
+			ignored = append(ignored, k)
+		}
+	}
+	sort.Strings(ignored)
+
+	var t []hclwrite.Tokens
+	for _, a := range ignored {
+		t = append(t, hclwrite.TokensForTraversal(hcl.Traversal{hcl.TraverseRoot{Name: a}}))
+	}
+
+	b.AppendNewline()
+	l := b.AppendNewBlock("lifecycle", nil).Body()
+	l.SetAttributeRaw("ignore_changes", hclwrite.TokensForTuple(t))
+}
@@ -0,0 +1,746 @@
+package exporter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/jmoiron/sqlx"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	providerFile  = "provider.tf"
+	importFile    = "imports.tf"
+	variableFile  = "variables.tf"
+	roleFile      = "roles.tf"
+	clusterFile   = "clusters.tf"
+	databaseFile  = "databases.tf"
+	objectFile    = "objects.tf"
+	grantFile     = "grants.tf"
+	publicRoleId  = "p"
+	publicRole    = "PUBLIC"
+	providerLabel = "materialize"
+)
+
+var invalidIdentifier = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// Generates Terraform configuration for the objects of an existing
+// Materialize environment. Every resource is paired with an import block
+// keyed by its catalog id so applying the configuration adopts the objects
+type Exporter struct {
+	conn      *sqlx.DB
+	databases []string
+	files     map[string]*hclwrite.File
+	names     map[string]bool
+	addresses map[string]string
+	roles     map[string]string
+	skipped   []string
+}
+
+// Exports the objects of the databases or of every database if none are given
+func NewExporter(conn *sqlx.DB, databases []string) *Exporter {
+	return &Exporter{
+		conn:      conn,
+		databases: databases,
+		files:     map[string]*hclwrite.File{},
+		names:     map[string]bool{},
+		addresses: map[string]string{},
+		roles:     map[string]string{"": publicRole, publicRoleId: publicRole},
+	}
+}
+
+// Objects that were found in the catalog but cannot be exported, with the
+// reason they were skipped
+func (e *Exporter) Skipped() []string {
+	return e.skipped
+}
+
+func (e *Exporter) Export() error {
+	e.provider()
+
+	if err := e.exportRoles(); err != nil {
+		return err
+	}
+
+	if err := e.exportClusters(); err != nil {
+		return err
+	}
+
+	return e.exportDatabases()
+}
+
+// Returns the formatted contents of each generated file
+func (e *Exporter) Files() map[string][]byte {
+	f := map[string][]byte{}
+	for name, file := range e.files {
+		f[name] = hclwrite.Format(file.Bytes())
+	}
+	return f
+}
+
+func (e *Exporter) Write(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for name, b := range e.Files() {
+		if err := os.WriteFile(filepath.Join(dir, name), b, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) skip(objectType, name, reason string) {
+	e.skipped = append(e.skipped, fmt.Sprintf("%s %s: %s", objectType, name, reason))
+}
+
+func (e *Exporter) body(file string) *hclwrite.Body {
+	f, ok := e.files[file]
+	if !ok {
+		f = hclwrite.NewEmptyFile()
+		e.files[file] = f
+	}
+
+	b := f.Body()
+	if len(b.Blocks()) > 0 {
+		b.AppendNewline()
+	}
+	return b
+}
+
+func (e *Exporter) provider() {
+	b := e.body(providerFile).AppendNewBlock("terraform", nil).Body()
+	p := b.AppendNewBlock("required_providers", nil).Body()
+	p.SetAttributeRaw(providerLabel, hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+		{
+			Name:  hclwrite.TokensForIdentifier("source"),
+			Value: hclwrite.TokensForValue(cty.StringVal("MaterializeInc/materialize")),
+		},
+	}))
+}
+
+// Converts the object name parts into a valid and unique resource name
+func (e *Exporter) resourceName(resourceType string, parts ...string) string {
+	n := invalidIdentifier.ReplaceAllString(strings.ToLower(strings.Join(parts, "_")), "_")
+	if n == "" || !(n[0] == '_' || (n[0] >= 'a' && n[0] <= 'z')) {
+		n = "_" + n
+	}
+
+	name := n
+	for i := 2; e.names[resourceType+"."+name]; i++ {
+		name = fmt.Sprintf("%s_%d", n, i)
+	}
+	e.names[resourceType+"."+name] = true
+	return name
+}
+
+// Adds a resource and the import block that adopts the existing object
+func (e *Exporter) resource(file, resourceType, id string, parts ...string) (*hclwrite.Body, string) {
+	r := hclwrite.NewBlock("resource", nil)
+	address := e.addResource(file, r, resourceType, id, parts...)
+	return r.Body(), address
+}
+
+// Adds a resource block that was built before it was known to be exportable
+func (e *Exporter) addResource(file string, r *hclwrite.Block, resourceType, id string, parts ...string) string {
+	name := e.resourceName(resourceType, parts...)
+	address := fmt.Sprintf("%s.%s", resourceType, name)
+
+	i := e.body(importFile).AppendNewBlock("import", nil).Body()
+	i.SetAttributeTraversal("to", traversal(address))
+	i.SetAttributeValue("id", cty.StringVal(id))
+
+	r.SetLabels([]string{resourceType, name})
+	e.body(file).AppendBlock(r)
+	return address
+}
+
+func traversal(address string, attributes ...string) hcl.Traversal {
+	parts := append(strings.Split(address, "."), attributes...)
+	t := hcl.Traversal{hcl.TraverseRoot{Name: parts[0]}}
+	for _, p := range parts[1:] {
+		t = append(t, hcl.TraverseAttr{Name: p})
+	}
+	return t
+}
+
+func setString(b *hclwrite.Body, name, value string) {
+	b.SetAttributeValue(name, cty.StringVal(value))
+}
+
+// Sets an optional attribute that the provider reads back as an empty string
+func setOptionalString(b *hclwrite.Body, name, value string) {
+	if value != "" {
+		setString(b, name, value)
+	}
+}
+
+// References the name of an exported resource so Terraform orders the
+// resources by dependency, falling back to the literal name
+func (e *Exporter) setName(b *hclwrite.Body, name, key, value string) {
+	if a, ok := e.addresses[key]; ok {
+		b.SetAttributeTraversal(name, traversal(a, "name"))
+		return
+	}
+	setString(b, name, value)
+}
+
+func roleKey(name string) string {
+	return fmt.Sprintf("role|%s", name)
+}
+
+func clusterKey(name string) string {
+	return fmt.Sprintf("cluster|%s", name)
+}
+
+func databaseKey(name string) string {
+	return fmt.Sprintf("database|%s", name)
+}
+
+func schemaKey(database, name string) string {
+	return fmt.Sprintf("schema|%s|%s", database, name)
+}
+
+func objectKey(database, schema, name string) string {
+	return fmt.Sprintf("object|%s|%s|%s", database, schema, name)
+}
+
+// Objects created by the system are managed by Materialize
+func userObject(id string) bool {
+	return strings.HasPrefix(id, "u")
+}
+
+func (e *Exporter) exportRoles() error {
+	roles, err := materialize.ListRoles(e.conn)
+	if err != nil {
+		return err
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].RoleName.String < roles[j].RoleName.String })
+
+	for _, r := range roles {
+		if !userObject(r.RoleId.String) {
+			continue
+		}
+
+		name := r.RoleName.String
+		b, address := e.resource(roleFile, "materialize_role", r.RoleId.String, name)
+		setString(b, "name", name)
+		setOptionalString(b, "comment", r.Comment.String)
+
+		e.roles[r.RoleId.String] = name
+		e.addresses[roleKey(name)] = address
+	}
+
+	return nil
+}
+
+func (e *Exporter) exportClusters() error {
+	clusters, err := materialize.ListClusters(e.conn)
+	if err != nil {
+		return err
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].ClusterName.String < clusters[j].ClusterName.String })
+
+	replicas, err := materialize.ListClusterReplicas(e.conn)
+	if err != nil {
+		return err
+	}
+	sort.Slice(replicas, func(i, j int) bool { return replicas[i].ReplicaName.String < replicas[j].ReplicaName.String })
+
+	for _, c := range clusters {
+		if !userObject(c.ClusterId.String) {
+			continue
+		}
+
+		name := c.ClusterName.String
+		b, address := e.resource(clusterFile, "materialize_cluster", c.ClusterId.String, name)
+		setString(b, "name", name)
+		e.setName(b, "ownership_role", roleKey(c.OwnerName.String), c.OwnerName.String)
+		setOptionalString(b, "comment", c.Comment.String)
+
+		if c.Managed.Bool {
+			setString(b, "size", c.Size.String)
+			b.SetAttributeValue("replication_factor", cty.NumberIntVal(c.ReplicationFactor.Int64))
+			b.SetAttributeValue("disk", cty.BoolVal(c.Disk.Bool))
+		}
+
+		e.addresses[clusterKey(name)] = address
+
+		e.grants("CLUSTER", "materialize_cluster_grant", c.ClusterId.String, c.OwnerName.String, c.Privileges.String, address, []string{name}, map[string]string{
+			"cluster_name": "name",
+		})
+
+		// The replicas of managed clusters are managed by Materialize
+		if c.Managed.Bool {
+			continue
+		}
+
+		for _, r := range replicas {
+			if r.ClusterName.String != name || !userObject(r.ReplicaId.String) {
+				continue
+			}
+
+			b, _ := e.resource(clusterFile, "materialize_cluster_replica", r.ReplicaId.String, name, r.ReplicaName.String)
+			setString(b, "name", r.ReplicaName.String)
+			b.SetAttributeTraversal("cluster_name", traversal(address, "name"))
+			setString(b, "size", r.Size.String)
+			b.SetAttributeValue("disk", cty.BoolVal(r.Disk.Bool))
+			setOptionalString(b, "availability_zone", r.AvailabilityZone.String)
+			setOptionalString(b, "comment", r.Comment.String)
+		}
+	}
+
+	return nil
+}
+
+func (e *Exporter) exportDatabase(database string) bool {
+	if len(e.databases) == 0 {
+		return true
+	}
+	for _, d := range e.databases {
+		if d == database {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Exporter) exportDatabases() error {
+	databases, err := materialize.ListDatabases(e.conn)
+	if err != nil {
+		return err
+	}
+	sort.Slice(databases, func(i, j int) bool { return databases[i].DatabaseName.String < databases[j].DatabaseName.String })
+
+	for _, d := range databases {
+		name := d.DatabaseName.String
+		if !userObject(d.DatabaseId.String) || !e.exportDatabase(name) {
+			continue
+		}
+
+		b, address := e.resource(databaseFile, "materialize_database", d.DatabaseId.String, name)
+		setString(b, "name", name)
+		e.setName(b, "ownership_role", roleKey(d.OwnerName.String), d.OwnerName.String)
+		setOptionalString(b, "comment", d.Comment.String)
+
+		e.addresses[databaseKey(name)] = address
+
+		e.grants("DATABASE", "materialize_database_grant", d.DatabaseId.String, d.OwnerName.String, d.Privileges.String, address, []string{name}, map[string]string{
+			"database_name": "name",
+		})
+
+		if err := e.exportSchemas(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Exporter) exportSchemas(database string) error {
+	schemas, err := materialize.ListSchemas(e.conn, database)
+	if err != nil {
+		return err
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].SchemaName.String < schemas[j].SchemaName.String })
+
+	for _, s := range schemas {
+		if !userObject(s.SchemaId.String) {
+			continue
+		}
+
+		name := s.SchemaName.String
+		b, address := e.resource(databaseFile, "materialize_schema", s.SchemaId.String, database, name)
+		setString(b, "name", name)
+		e.setName(b, "database_name", databaseKey(database), database)
+		e.setName(b, "ownership_role", roleKey(s.OwnerName.String), s.OwnerName.String)
+		setOptionalString(b, "comment", s.Comment.String)
+
+		e.addresses[schemaKey(database, name)] = address
+
+		e.grants("SCHEMA", "materialize_schema_grant", s.SchemaId.String, s.OwnerName.String, s.Privileges.String, address, []string{database, name}, map[string]string{
+			"schema_name":   "name",
+			"database_name": "database_name",
+		})
+
+		if err := e.exportObjects(database, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sets the schema and database of an object in a schema
+func (e *Exporter) setNamespace(b *hclwrite.Body, database, schema string) {
+	if a, ok := e.addresses[schemaKey(database, schema)]; ok {
+		b.SetAttributeTraversal("schema_name", traversal(a, "name"))
+		b.SetAttributeTraversal("database_name", traversal(a, "database_name"))
+		return
+	}
+	setString(b, "schema_name", schema)
+	setString(b, "database_name", database)
+}
+
+// Attributes that identify an object in a schema on its grant resource
+func namespacedGrantAttributes(nameAttribute string) map[string]string {
+	return map[string]string{
+		nameAttribute:   "name",
+		"schema_name":   "schema_name",
+		"database_name": "database_name",
+	}
+}
+
+func (e *Exporter) exportObjects(database, schema string) error {
+	if err := e.exportSecrets(database, schema); err != nil {
+		return err
+	}
+
+	if err := e.exportConnections(database, schema); err != nil {
+		return err
+	}
+
+	if err := e.exportTypes(database, schema); err != nil {
+		return err
+	}
+
+	if err := e.exportSources(database, schema); err != nil {
+		return err
+	}
+
+	if err := e.exportTables(database, schema); err != nil {
+		return err
+	}
+
+	if err := e.exportViews(database, schema); err != nil {
+		return err
+	}
+
+	if err := e.exportMaterializedViews(database, schema); err != nil {
+		return err
+	}
+
+	if err := e.exportSinks(database, schema); err != nil {
+		return err
+	}
+
+	return e.exportIndexes(database, schema)
+}
+
+func (e *Exporter) exportSecrets(database, schema string) error {
+	secrets, err := materialize.ListSecrets(e.conn, schema, database)
+	if err != nil {
+		return err
+	}
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].SecretName.String < secrets[j].SecretName.String })
+
+	for _, s := range secrets {
+		name := s.SecretName.String
+		b, address := e.resource(objectFile, "materialize_secret", s.SecretId.String, database, schema, name)
+		setString(b, "name", name)
+		e.setNamespace(b, database, schema)
+		e.setName(b, "ownership_role", roleKey(s.OwnerName.String), s.OwnerName.String)
+		setOptionalString(b, "comment", s.Comment.String)
+
+		// Secret values cannot be read from the catalog. The variable defaults to
+		// null so the import plans cleanly and is only needed to create the secret
+		variable := e.resourceName("variable", "secret", database, schema, name)
+		v := e.body(variableFile).AppendNewBlock("variable", []string{variable}).Body()
+		v.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+		v.SetAttributeValue("sensitive", cty.True)
+		v.SetAttributeValue("default", cty.NullVal(cty.String))
+		b.SetAttributeTraversal("value", traversal("var."+variable))
+
+		e.addresses[objectKey(database, schema, name)] = address
+
+		e.grants("SECRET", "materialize_secret_grant", s.SecretId.String, s.OwnerName.String, s.Privileges.String, address, []string{database, schema, name}, namespacedGrantAttributes("secret_name"))
+	}
+
+	return nil
+}
+
+func (e *Exporter) exportTables(database, schema string) error {
+	tables, err := materialize.ListTables(e.conn, schema, database)
+	if err != nil {
+		return err
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].TableName.String < tables[j].TableName.String })
+
+	for _, t := range tables {
+		name := t.TableName.String
+
+		if t.SourceId.Valid {
+			e.skip("source table", materialize.QualifiedName(database, schema, name), "the options of tables created from a source are not in the catalog")
+			continue
+		}
+
+		b, address := e.resource(objectFile, "materialize_table", t.TableId.String, database, schema, name)
+		setString(b, "name", name)
+		e.setNamespace(b, database, schema)
+		e.setName(b, "ownership_role", roleKey(t.OwnerName.String), t.OwnerName.String)
+		setOptionalString(b, "comment", t.Comment.String)
+
+		e.addresses[objectKey(database, schema, name)] = address
+
+		columns, err := materialize.ListTableColumns(e.conn, t.TableId.String)
+		if err != nil {
+			return err
+		}
+
+		for _, c := range columns {
+			cb := b.AppendNewBlock("column", nil).Body()
+			setString(cb, "name", c.Name.String)
+			setString(cb, "type", c.Type.String)
+			// The provider uses nullable to mean NOT NULL
+			cb.SetAttributeValue("nullable", cty.BoolVal(!c.Nullable.Bool))
+			setOptionalString(cb, "default", c.Default.String)
+			setOptionalString(cb, "comment", c.Comment.String)
+		}
+
+		e.grants("TABLE", "materialize_table_grant", t.TableId.String, t.OwnerName.String, t.Privileges.String, address, []string{database, schema, name}, namespacedGrantAttributes("table_name"))
+	}
+
+	return nil
+}
+
+func (e *Exporter) exportViews(database, schema string) error {
+	views, err := materialize.ListViews(e.conn, schema, database)
+	if err != nil {
+		return err
+	}
+	sort.Slice(views, func(i, j int) bool { return views[i].ViewName.String < views[j].ViewName.String })
+
+	for _, v := range views {
+		name := v.ViewName.String
+		b, address := e.resource(objectFile, "materialize_view", v.ViewId.String, database, schema, name)
+		setString(b, "name", name)
+		e.setNamespace(b, database, schema)
+		e.setName(b, "ownership_role", roleKey(v.OwnerName.String), v.OwnerName.String)
+		setOptionalString(b, "comment", v.Comment.String)
+		setString(b, "statement", materialize.CreateStatement(v.CreateSql.String))
+
+		e.addresses[objectKey(database, schema, name)] = address
+
+		e.grants("VIEW", "materialize_view_grant", v.ViewId.String, v.OwnerName.String, v.Privileges.String, address, []string{database, schema, name}, namespacedGrantAttributes("view_name"))
+	}
+
+	return nil
+}
+
+func (e *Exporter) exportMaterializedViews(database, schema string) error {
+	views, err := materialize.ListMaterializedViews(e.conn, schema, database)
+	if err != nil {
+		return err
+	}
+	sort.Slice(views, func(i, j int) bool {
+		return views[i].MaterializedViewName.String < views[j].MaterializedViewName.String
+	})
+
+	for _, v := range views {
+		name := v.MaterializedViewName.String
+		b, address := e.resource(objectFile, "materialize_materialized_view", v.MaterializedViewId.String, database, schema, name)
+		setString(b, "name", name)
+		e.setNamespace(b, database, schema)
+		e.setName(b, "cluster_name", clusterKey(v.Cluster.String), v.Cluster.String)
+		e.setName(b, "ownership_role", roleKey(v.OwnerName.String), v.OwnerName.String)
+		setOptionalString(b, "comment", v.Comment.String)
+		setString(b, "statement", materialize.CreateStatement(v.CreateSql.String))
+
+		// Materialized views without a configured retain history report the
+		// default compaction window in milliseconds
+		if ms, err := strconv.ParseInt(v.RetainHistory.String, 10, 64); err == nil {
+			if r := time.Duration(ms) * time.Millisecond; r != materialize.DefaultRetainHistory {
+				setString(b, "retain_history", materialize.FormatInterval(r))
+			}
+		}
+
+		strategies, err := materialize.ListMaterializedViewRefreshStrategies(e.conn, v.MaterializedViewId.String)
+		if err != nil {
+			return err
+		}
+		exportRefresh(b, strategies)
+
+		e.addresses[objectKey(database, schema, name)] = address
+
+		e.grants("MATERIALIZED VIEW", "materialize_materialized_view_grant", v.MaterializedViewId.String, v.OwnerName.String, v.Privileges.String, address, []string{database, schema, name}, namespacedGrantAttributes("materialized_view_name"))
+	}
+
+	return nil
+}
+
+func (e *Exporter) exportTypes(database, schema string) error {
+	types, err := materialize.ListTypes(e.conn, schema, database)
+	if err != nil {
+		return err
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].TypeName.String < types[j].TypeName.String })
+
+	for _, t := range types {
+		name := t.TypeName.String
+
+		if !t.ElementType.Valid && !t.KeyType.Valid {
+			e.skip("type", materialize.QualifiedName(database, schema, name), "only list and map types can be created by the provider")
+			continue
+		}
+
+		b, address := e.resource(objectFile, "materialize_type", t.TypeId.String, database, schema, name)
+		setString(b, "name", name)
+		e.setNamespace(b, database, schema)
+		e.setName(b, "ownership_role", roleKey(t.OwnerName.String), t.OwnerName.String)
+		setOptionalString(b, "comment", t.Comment.String)
+
+		if t.ElementType.Valid {
+			lb := b.AppendNewBlock("list_properties", nil).Body()
+			setString(lb, "element_type", t.ElementType.String)
+		} else {
+			mb := b.AppendNewBlock("map_properties", nil).Body()
+			setString(mb, "key_type", t.KeyType.String)
+			setString(mb, "value_type", t.ValueType.String)
+		}
+
+		e.grants("TYPE", "materialize_type_grant", t.TypeId.String, t.OwnerName.String, t.Privileges.String, address, []string{database, schema, name}, namespacedGrantAttributes("type_name"))
+	}
+
+	return nil
+}
+
+// Indexes are exported for the objects in the schema, the index belongs to the
+// schema of the object it is created on
+func (e *Exporter) exportIndexes(database, schema string) error {
+	indexes, err := materialize.ListIndexes(e.conn, schema, database)
+	if err != nil {
+		return err
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i].IndexName.String < indexes[j].IndexName.String })
+
+	for _, i := range indexes {
+		if !userObject(i.IndexId.String) {
+			continue
+		}
+
+		name := i.IndexName.String
+		b, _ := e.resource(objectFile, "materialize_index", i.IndexId.String, database, schema, name)
+		setString(b, "name", name)
+		e.setName(b, "cluster_name", clusterKey(i.ClusterName.String), i.ClusterName.String)
+		setOptionalString(b, "comment", i.Comment.String)
+
+		ob := b.AppendNewBlock("obj_name", nil).Body()
+		e.setName(ob, "name", objectKey(database, schema, i.ObjectName.String), i.ObjectName.String)
+		e.setNamespace(ob, database, schema)
+
+		columns, err := materialize.ListIndexColumns(e.conn, i.IndexId.String)
+		if err != nil {
+			return err
+		}
+
+		for _, c := range columns {
+			cb := b.AppendNewBlock("col_expr", nil).Body()
+			setString(cb, "field", c.Name.String)
+		}
+	}
+
+	return nil
+}
+
+// Adds the refresh block unless the materialized view only refreshes on
+// commit, which is the default
+func exportRefresh(b *hclwrite.Body, strategies []materialize.RefreshStrategyParams) {
+	var onCommit bool
+	var at []cty.Value
+	var every [][2]string
+	for _, s := range strategies {
+		switch s.Type.String {
+		case "on-commit":
+			onCommit = true
+		case "at":
+			at = append(at, cty.StringVal(exportTimestamp(s.At.String)))
+		case "every":
+			every = append(every, [2]string{s.Interval.String, exportTimestamp(s.AlignedTo.String)})
+		}
+	}
+
+	if len(at) == 0 && len(every) == 0 {
+		return
+	}
+
+	r := b.AppendNewBlock("refresh", nil).Body()
+	if onCommit {
+		r.SetAttributeValue("on_commit", cty.True)
+	}
+	if len(at) > 0 {
+		r.SetAttributeValue("at", cty.ListVal(at))
+	}
+	for _, e := range every {
+		eb := r.AppendNewBlock("every", nil).Body()
+		setString(eb, "interval", e[0])
+		setOptionalString(eb, "aligned_to", e[1])
+	}
+}
+
+func exportTimestamp(t string) string {
+	ts, err := materialize.ParseTimestamp(t)
+	if err != nil {
+		return t
+	}
+	return materialize.FormatTimestamp(ts)
+}
+
+// Adds a grant resource for each privilege held by a role other than the
+// owner. The object attributes of the grant reference the object resource
+func (e *Exporter) grants(objectType, resourceType, objectId, owner, privileges, address string, parts []string, attributes map[string]string) {
+	if privileges == "" || privileges == "{}" {
+		return
+	}
+
+	p := materialize.ParsePrivileges(privileges)
+
+	var roleIds []string
+	for id := range p {
+		roleIds = append(roleIds, id)
+	}
+	sort.Strings(roleIds)
+
+	var keys []string
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range roleIds {
+		role, ok := e.roles[key]
+		// Privileges of system roles are managed by Materialize
+		if !ok || role == owner {
+			continue
+		}
+
+		roleId := key
+		if role == publicRole {
+			roleId = publicRoleId
+		}
+
+		for _, privilege := range p[key] {
+			if privilege == "" {
+				continue
+			}
+
+			id := fmt.Sprintf("GRANT|%s|%s|%s|%s", objectType, objectId, roleId, privilege)
+			n := append(append([]string{}, parts...), role, privilege)
+			b, _ := e.resource(grantFile, resourceType, id, n...)
+			e.setName(b, "role_name", roleKey(role), role)
+			setString(b, "privilege", privilege)
+			for _, k := range keys {
+				b.SetAttributeTraversal(k, traversal(address, attributes[k]))
+			}
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package exporter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/resources"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockRoleScan(mock, "")
+		testhelpers.MockClusterScan(mock, "")
+		testhelpers.MockClusterReplicaScan(mock, "")
+		testhelpers.MockDatabaseScan(mock, "")
+		testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database'`)
+
+		sp := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockSecretScan(mock, sp)
+		testhelpers.MockConnectionScan(mock, sp)
+		testhelpers.MockTypeScan(mock, sp)
+		testhelpers.MockSourceScan(mock, sp)
+		testhelpers.MockTableScan(mock, sp)
+		testhelpers.MockTableColumnScan(mock, `WHERE mz_columns.id = 'u1'`)
+		testhelpers.MockViewScan(mock, sp)
+		testhelpers.MockMaterializeViewScan(mock, sp)
+		testhelpers.MockMaterializedViewRefreshStrategyScan(mock, `WHERE mz_materialized_view_refresh_strategies.materialized_view_id = 'u1'`)
+		testhelpers.MockSinkScan(mock, sp)
+		testhelpers.MockIndexScan(mock, `
+		WHERE mz_databases.name = 'database'
+		AND mz_objects.type IN \('source', 'view', 'materialized-view'\)
+		AND mz_schemas.name = 'schema'`)
+		testhelpers.MockIndexColumnScan(mock, `WHERE mz_indexes.id = 'u1'`)
+
+		e := NewExporter(db, nil)
+		r.NoError(e.Export())
+
+		f := e.Files()
+		r.Contains(string(f[providerFile]), `source = "MaterializeInc/materialize"`)
+		r.Contains(string(f[roleFile]), `resource "materialize_role" "joe"`)
+
+		c := string(f[clusterFile])
+		r.Contains(c, `resource "materialize_cluster" "cluster"`)
+		r.Contains(c, `ownership_role     = materialize_role.joe.name`)
+		r.Contains(c, `replication_factor = 2`)
+		r.NotContains(c, `materialize_cluster_replica`)
+
+		d := string(f[databaseFile])
+		r.Contains(d, `resource "materialize_schema" "database_schema"`)
+		r.Contains(d, `database_name  = materialize_database.database.name`)
+
+		o := string(f[objectFile])
+		r.Contains(o, `schema_name    = materialize_schema.database_schema.name`)
+		r.Contains(o, `value          = var.secret_database_schema_secret`)
+		r.Contains(o, `ownership_role = "materialize"`)
+		r.Contains(o, `type     = "integer"`)
+		r.Contains(o, `statement      = "SELECT 1 FROM 1"`)
+		r.Contains(o, `cluster_name   = materialize_cluster.cluster.name`)
+		r.NotContains(resourceBlock(o, "materialize_materialized_view"), `ignore_changes`)
+		r.Contains(o, `retain_history = "1 hour"`)
+		r.Contains(o, `interval   = "1 day"`)
+		r.Contains(o, `aligned_to = "2024-01-01 00:00:00+00:00"`)
+		r.Contains(o, `resource "materialize_type" "database_schema_type"`)
+		r.Contains(o, `element_type = "int4"`)
+		r.Contains(o, `resource "materialize_index" "database_schema_index"`)
+		r.Contains(o, `cluster_name = materialize_cluster.cluster.name`)
+		r.Contains(o, `field = "column"`)
+
+		r.Contains(string(f[variableFile]), `variable "secret_database_schema_secret"`)
+
+		g := string(f[grantFile])
+		r.Contains(g, `resource "materialize_table_grant" "database_schema_table_joe_insert"`)
+		r.Contains(g, `table_name    = materialize_table.database_schema_table.name`)
+		r.NotContains(g, `materialize_cluster_grant`)
+
+		i := string(f[importFile])
+		r.Contains(i, "to = materialize_database.database\n  id = \"u1\"")
+		r.Contains(i, `id = "GRANT|TABLE|u1|u1|SELECT"`)
+
+		r.Contains(o, `resource "materialize_connection_kafka" "database_schema_connection"`)
+		r.Contains(o, `broker = "localhost:9092"`)
+		r.Contains(o, `resource "materialize_source_kafka" "database_schema_source"`)
+		r.Contains(o, `name          = materialize_connection_kafka.database_schema_connection.name`)
+		r.Contains(o, `bytes = true`)
+		r.Contains(o, `resource "materialize_sink_kafka" "database_schema_sink"`)
+		r.Contains(o, `name          = materialize_table.database_schema_table.name`)
+
+		// Attributes that are not read back are ignored to import without changes
+		r.Contains(resourceBlock(o, "materialize_source_kafka"), `ignore_changes = [envelope, expose_progress, format,`)
+		r.NotContains(resourceBlock(o, "materialize_source_kafka"), `cluster_name, `)
+		r.Contains(resourceBlock(o, "materialize_sink_kafka"), `snapshot, topic_config,`)
+		r.NotContains(resourceBlock(o, "materialize_sink_kafka"), `, topic,`)
+
+		r.Empty(e.Skipped())
+	})
+}
+
+// Returns the first resource block of the type
+func resourceBlock(f, resourceType string) string {
+	i := strings.Index(f, fmt.Sprintf(`resource "%s"`, resourceType))
+	if i < 0 {
+		return ""
+	}
+	if j := strings.Index(f[i:], "\n}\n"); j >= 0 {
+		return f[i : i+j]
+	}
+	return f[i:]
+}
+
+func TestResourceName(t *testing.T) {
+	r := require.New(t)
+	e := NewExporter(nil, nil)
+
+	r.Equal("database_my_schema", e.resourceName("materialize_schema", "Database", "my schema"))
+	r.Equal("database_my_schema_2", e.resourceName("materialize_schema", "database", "my.schema"))
+	r.Equal("database_my_schema", e.resourceName("materialize_table", "database", "my schema"))
+	r.Equal("_1table", e.resourceName("materialize_table", "1table"))
+}
+
+func TestGrantsSkipOwnerAndSystemRoles(t *testing.T) {
+	r := require.New(t)
+	e := NewExporter(nil, nil)
+	e.roles["u2"] = "joe"
+
+	e.grants("DATABASE", "materialize_database_grant", "u1", "joe", "{u2=UC/u2,s1=U/u2,=U/u2}", "materialize_database.database", []string{"database"}, map[string]string{
+		"database_name": "name",
+	})
+
+	g := string(e.Files()[grantFile])
+	r.Contains(g, `resource "materialize_database_grant" "database_public_usage"`)
+	r.Contains(g, `role_name     = "PUBLIC"`)
+	r.NotContains(g, `joe`)
+	r.Contains(string(e.Files()[importFile]), `id = "GRANT|DATABASE|u1|p|USAGE"`)
+}
+
+func TestExportSourceSubsourceTables(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		d := mock.NewRows([]string{"object_id", "referenced_object_id", "object_name", "schema_name", "database_name", "type"}).
+			AddRow("u3", "u2", "t", "schema", "database", "source").
+			AddRow("u4", "u2", "u", "other", "database", "source").
+			AddRow("u5", "u2", "v", "schema", "database", "view")
+		mock.ExpectQuery(`FROM mz_internal.mz_object_dependencies`).WithArgs("u2").WillReturnRows(d)
+
+		for _, s := range []struct{ id, create string }{
+			{"u3", `CREATE SUBSOURCE "database"."schema"."t" ("a" int4) OF SOURCE [u2 AS "database"."schema"."pg"] WITH (EXTERNAL REFERENCE = "postgres"."public"."t")`},
+			{"u4", `CREATE SUBSOURCE "database"."other"."u" ("a" int4) OF SOURCE [u2 AS "database"."schema"."pg"] WITH (EXTERNAL REFERENCE = "postgres"."public"."u")`},
+		} {
+			rows := mock.NewRows([]string{"id", "source_type", "create_sql"}).AddRow(s.id, "subsource", s.create)
+			mock.ExpectQuery(`FROM mz_sources`).WithArgs(s.id).WillReturnRows(rows)
+		}
+
+		e := NewExporter(db, nil)
+		tables, err := e.subsourceTables("u2", "database", "schema")
+		r.NoError(err)
+		r.Equal([]materialize.TableStruct{
+			{Name: "public.t", Alias: "t"},
+			{Name: "public.u", Alias: "database.other.u"},
+		}, tables)
+	})
+}
+
+func TestExportSourceWebhook(t *testing.T) {
+	r := require.New(t)
+	e := NewExporter(nil, nil)
+	e.addresses[objectKey("database", "schema", "key")] = "materialize_secret.database_schema_key"
+
+	d, err := materialize.ParseSourceDefinition(`CREATE SOURCE "database"."schema"."w" IN CLUSTER [u1] FROM WEBHOOK BODY FORMAT JSON INCLUDE HEADERS ('x-id') CHECK (WITH (BODY AS "b", SECRET [u2 AS "database"."schema"."key"]) constant_time_eq(b, "key"))`)
+	r.NoError(err)
+
+	b := hclwrite.NewEmptyFile()
+	r.NoError(e.setSource(b.Body(), resources.SourceWebhook(), d))
+
+	w := string(hclwrite.Format(b.Bytes()))
+	r.Contains(w, `body_format = "JSON"`)
+	r.Contains(w, `only = ["x-id"]`)
+	r.Contains(w, `name          = materialize_secret.database_schema_key.name`)
+	r.Contains(w, `check_expression = "constant_time_eq(b, key)"`)
+}
+
+func TestExportUnsupportedOptions(t *testing.T) {
+	r := require.New(t)
+	e := NewExporter(nil, nil)
+
+	d, err := materialize.ParseSourceDefinition(`CREATE SOURCE "database"."schema"."s" FROM KAFKA CONNECTION [u1 AS "database"."schema"."kafka"] (TOPIC = 't', GROUP ID PREFIX = 'p') FORMAT BYTES`)
+	r.NoError(err)
+	r.EqualError(e.setSource(hclwrite.NewEmptyFile().Body(), resources.SourceKafka(), d), "option GROUP ID PREFIX is not supported by the provider")
+
+	c, err := materialize.ParseConnectionDefinition(`CREATE CONNECTION "database"."schema"."pg" TO POSTGRES (HOST = 'h', PORT = 'x', USER = 'u', DATABASE = 'd')`)
+	r.NoError(err)
+	r.ErrorContains(e.setConnection(hclwrite.NewEmptyFile().Body(), resources.ConnectionPostgres(), c), "option PORT")
+}
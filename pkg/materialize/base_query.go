@@ -6,6 +6,54 @@ import (
 	"strings"
 )
 
+// A condition in a WHERE clause. Values are passed to the driver as bind
+// parameters so they never need to be quoted
+type Predicate struct {
+	column   string
+	operator string
+	values   []interface{}
+}
+
+func Equal(column, value string) Predicate {
+	return Predicate{column: column, operator: "=", values: []interface{}{value}}
+}
+
+func In(column string, values ...string) Predicate {
+	v := make([]interface{}, len(values))
+	for i, value := range values {
+		v[i] = value
+	}
+	return Predicate{column: column, operator: "IN", values: v}
+}
+
+func Like(column, pattern string) Predicate {
+	return Predicate{column: column, operator: "LIKE", values: []interface{}{pattern}}
+}
+
+func IsNull(column string) Predicate {
+	return Predicate{column: column, operator: "IS NULL"}
+}
+
+func IsNotNull(column string) Predicate {
+	return Predicate{column: column, operator: "IS NOT NULL"}
+}
+
+// Renders the predicate with bind parameters numbered from start
+func (p Predicate) clause(start int) string {
+	switch p.operator {
+	case "IS NULL", "IS NOT NULL":
+		return fmt.Sprintf(`%s %s`, p.column, p.operator)
+	case "IN":
+		var b []string
+		for i := range p.values {
+			b = append(b, fmt.Sprintf(`$%d`, start+i))
+		}
+		return fmt.Sprintf(`%s IN (%s)`, p.column, strings.Join(b, ", "))
+	default:
+		return fmt.Sprintf(`%s %s $%d`, p.column, p.operator, start)
+	}
+}
+
 type BaseQuery struct {
 	statement       string
 	customPredicate []string
@@ -26,27 +74,43 @@ func (b *BaseQuery) Order(c string) *BaseQuery {
 	return b
 }
 
-func (b *BaseQuery) QueryPredicate(predicate map[string]string) string {
+// Builds the query for the predicates returning the statement and the
+// arguments for its bind parameters
+func (b *BaseQuery) Query(predicates ...Predicate) (string, []interface{}) {
 	q := strings.Builder{}
 	q.WriteString(b.statement)
 
-	var p []string
+	type clause struct {
+		key       string
+		predicate *Predicate
+	}
 
-	// predicate mapping
-	for k, v := range predicate {
-		if v != "" {
-			p = append(p, fmt.Sprintf(`%s = %s`, k, QuoteString(v)))
-		}
+	var c []clause
+	for i := range predicates {
+		p := predicates[i]
+		c = append(c, clause{key: fmt.Sprintf(`%s %s`, p.column, p.operator), predicate: &p})
 	}
 
 	// custom predicates
-	p = append(p, b.customPredicate...)
+	for _, p := range b.customPredicate {
+		c = append(c, clause{key: p})
+	}
 
-	if len(p) > 0 {
-		// sort predicateds for testing consistency
-		sort.Strings(p)
-		f := strings.Join(p, " AND ")
-		q.WriteString(fmt.Sprintf(` WHERE %s`, f))
+	var args []interface{}
+	if len(c) > 0 {
+		// sort predicates for testing consistency
+		sort.SliceStable(c, func(i, j int) bool { return c[i].key < c[j].key })
+
+		var w []string
+		for _, p := range c {
+			if p.predicate == nil {
+				w = append(w, p.key)
+				continue
+			}
+			w = append(w, p.predicate.clause(len(args)+1))
+			args = append(args, p.predicate.values...)
+		}
+		q.WriteString(fmt.Sprintf(` WHERE %s`, strings.Join(w, " AND ")))
 	}
 
 	if b.order != "" {
@@ -54,5 +118,21 @@ func (b *BaseQuery) QueryPredicate(predicate map[string]string) string {
 	}
 
 	q.WriteString(";")
-	return q.String()
+	return q.String(), args
+}
+
+// Returns an equality predicate for each non empty value
+func EqualPredicates(predicate map[string]string) []Predicate {
+	var p []Predicate
+	for k, v := range predicate {
+		if v != "" {
+			p = append(p, Equal(k, v))
+		}
+	}
+	return p
+}
+
+// Builds the query with an equality predicate for each non empty value
+func (b *BaseQuery) QueryPredicate(predicate map[string]string) (string, []interface{}) {
+	return b.Query(EqualPredicates(predicate)...)
 }
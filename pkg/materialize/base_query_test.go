@@ -12,8 +12,9 @@ func TestQueryPredicateBasic(t *testing.T) {
 	r := require.New(t)
 	b := NewBaseQuery(statement)
 
-	q := b.QueryPredicate(map[string]string{})
+	q, args := b.QueryPredicate(map[string]string{})
 	r.Equal(`SELECT * FROM table;`, q)
+	r.Empty(args)
 }
 
 func TestQueryPredicateParams(t *testing.T) {
@@ -27,8 +28,27 @@ func TestQueryPredicateParams(t *testing.T) {
 	}
 	b := NewBaseQuery(statement)
 
-	q := b.QueryPredicate(p)
-	r.Equal(`SELECT * FROM table WHERE az = 'us-east-1' AND cluster = 'cluster_name' AND database = 'database_name' AND schema = 'schema_name' AND table = 'table_name';`, q)
+	q, args := b.QueryPredicate(p)
+	r.Equal(`SELECT * FROM table WHERE az = $1 AND cluster = $2 AND database = $3 AND schema = $4 AND table = $5;`, q)
+	r.Equal([]interface{}{"us-east-1", "cluster_name", "database_name", "schema_name", "table_name"}, args)
+}
+
+func TestQueryPredicateEmptyParams(t *testing.T) {
+	r := require.New(t)
+	b := NewBaseQuery(statement)
+
+	q, args := b.QueryPredicate(map[string]string{"table": "table_name", "schema": ""})
+	r.Equal(`SELECT * FROM table WHERE table = $1;`, q)
+	r.Equal([]interface{}{"table_name"}, args)
+}
+
+func TestQueryPredicateQuotedParams(t *testing.T) {
+	r := require.New(t)
+	b := NewBaseQuery(statement)
+
+	q, args := b.QueryPredicate(map[string]string{"table": "it's"})
+	r.Equal(`SELECT * FROM table WHERE table = $1;`, q)
+	r.Equal([]interface{}{"it's"}, args)
 }
 
 func TestQueryPredicateAdditionalParams(t *testing.T) {
@@ -36,8 +56,9 @@ func TestQueryPredicateAdditionalParams(t *testing.T) {
 	b := NewBaseQuery(statement)
 	b.CustomPredicate([]string{"salary BETWEEN 1 AND 10"})
 
-	q := b.QueryPredicate(map[string]string{})
+	q, args := b.QueryPredicate(map[string]string{})
 	r.Equal(`SELECT * FROM table WHERE salary BETWEEN 1 AND 10;`, q)
+	r.Empty(args)
 }
 
 func TestQueryPredicateAllParams(t *testing.T) {
@@ -45,6 +66,35 @@ func TestQueryPredicateAllParams(t *testing.T) {
 	b := NewBaseQuery(statement)
 	b.CustomPredicate([]string{"salary BETWEEN 1 AND 10"})
 
-	q := b.QueryPredicate(map[string]string{"table": "table_name"})
-	r.Equal(`SELECT * FROM table WHERE salary BETWEEN 1 AND 10 AND table = 'table_name';`, q)
+	q, args := b.QueryPredicate(map[string]string{"table": "table_name"})
+	r.Equal(`SELECT * FROM table WHERE salary BETWEEN 1 AND 10 AND table = $1;`, q)
+	r.Equal([]interface{}{"table_name"}, args)
+}
+
+func TestQueryIn(t *testing.T) {
+	r := require.New(t)
+	b := NewBaseQuery(statement)
+
+	q, args := b.Query(In("type", "source", "view"), Equal("schema", "public"))
+	r.Equal(`SELECT * FROM table WHERE schema = $1 AND type IN ($2, $3);`, q)
+	r.Equal([]interface{}{"public", "source", "view"}, args)
+}
+
+func TestQueryLike(t *testing.T) {
+	r := require.New(t)
+	b := NewBaseQuery(statement)
+
+	q, args := b.Query(Like("name", "prod_%"))
+	r.Equal(`SELECT * FROM table WHERE name LIKE $1;`, q)
+	r.Equal([]interface{}{"prod_%"}, args)
+}
+
+func TestQueryIsNull(t *testing.T) {
+	r := require.New(t)
+	b := NewBaseQuery(statement)
+	b.Order("name")
+
+	q, args := b.Query(IsNull("comment"), IsNotNull("owner"), Equal("name", "table_name"))
+	r.Equal(`SELECT * FROM table WHERE comment IS NULL AND name = $1 AND owner IS NOT NULL ORDER BY name;`, q)
+	r.Equal([]interface{}{"table_name"}, args)
 }
@@ -8,6 +8,19 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+type SchedulingStruct struct {
+	OnRefresh             bool
+	HydrationTimeEstimate string
+}
+
+// Options for graceful reconfiguration, the new replicas are provisioned
+// alongside the existing ones until they are hydrated or the timeout expires
+type WaitUntilReadyStruct struct {
+	Enabled   bool
+	Timeout   string
+	OnTimeout string
+}
+
 // DDL
 type ClusterBuilder struct {
 	ddl                        Builder
@@ -19,6 +32,7 @@ type ClusterBuilder struct {
 	introspectionInterval      string
 	introspectionDebugging     bool
 	idleArrangementMergeEffort int
+	scheduling                 SchedulingStruct
 }
 
 func NewClusterBuilder(conn *sqlx.DB, obj MaterializeObject) *ClusterBuilder {
@@ -67,6 +81,41 @@ func (b *ClusterBuilder) IdleArrangementMergeEffort(e int) *ClusterBuilder {
 	return b
 }
 
+func (b *ClusterBuilder) Scheduling(s SchedulingStruct) *ClusterBuilder {
+	b.scheduling = s
+	return b
+}
+
+func (s SchedulingStruct) clause() string {
+	if !s.OnRefresh {
+		return `SCHEDULE = MANUAL`
+	}
+
+	if s.HydrationTimeEstimate != "" {
+		return fmt.Sprintf(`SCHEDULE = ON REFRESH (HYDRATION TIME ESTIMATE = %s)`, QuoteString(s.HydrationTimeEstimate))
+	}
+	return `SCHEDULE = ON REFRESH`
+}
+
+func (w WaitUntilReadyStruct) clause() string {
+	if !w.Enabled {
+		return ""
+	}
+
+	var o []string
+	if w.Timeout != "" {
+		o = append(o, fmt.Sprintf(`TIMEOUT = %s`, QuoteString(w.Timeout)))
+	}
+	if w.OnTimeout != "" {
+		o = append(o, fmt.Sprintf(`ON TIMEOUT = %s`, QuoteString(w.OnTimeout)))
+	}
+
+	if len(o) == 0 {
+		return ` WITH (WAIT UNTIL READY)`
+	}
+	return fmt.Sprintf(` WITH (WAIT UNTIL READY (%s))`, strings.Join(o, ", "))
+}
+
 func (b *ClusterBuilder) Create() error {
 	q := strings.Builder{}
 
@@ -111,6 +160,10 @@ func (b *ClusterBuilder) Create() error {
 			p = append(p, m)
 		}
 
+		if b.scheduling.OnRefresh {
+			p = append(p, fmt.Sprintf(` %s`, b.scheduling.clause()))
+		}
+
 		if len(p) > 0 {
 			p := strings.Join(p[:], ",")
 			q.WriteString(fmt.Sprintf(`,%s`, p))
@@ -129,8 +182,13 @@ func (b *ClusterBuilder) Drop() error {
 	return b.ddl.drop(qn)
 }
 
-func (b *ClusterBuilder) Resize(newSize string) error {
-	q := fmt.Sprintf(`ALTER CLUSTER %s SET (SIZE '%s');`, b.QualifiedName(), newSize)
+func (b *ClusterBuilder) Resize(newSize string, wait WaitUntilReadyStruct) error {
+	q := fmt.Sprintf(`ALTER CLUSTER %s SET (SIZE %s)%s;`, b.QualifiedName(), QuoteString(newSize), wait.clause())
+	return b.ddl.exec(q)
+}
+
+func (b *ClusterBuilder) SetScheduling(s SchedulingStruct) error {
+	q := fmt.Sprintf(`ALTER CLUSTER %s SET (%s);`, b.QualifiedName(), s.clause())
 	return b.ddl.exec(q)
 }
 
@@ -173,6 +231,8 @@ type ClusterParams struct {
 	Size              sql.NullString `db:"size"`
 	ReplicationFactor sql.NullInt64  `db:"replication_factor"`
 	Disk              sql.NullBool   `db:"disk"`
+	ScheduleType      sql.NullString `db:"schedule_type"`
+	HydrationEstimate sql.NullString `db:"hydration_time_estimate"`
 	Comment           sql.NullString `db:"comment"`
 	OwnerName         sql.NullString `db:"owner_name"`
 	Privileges        sql.NullString `db:"privileges"`
@@ -186,12 +246,16 @@ var clusterQuery = NewBaseQuery(`
 		mz_clusters.size,
 		mz_clusters.replication_factor,
 		mz_clusters.disk,
+		mz_cluster_schedules.type AS schedule_type,
+		mz_cluster_schedules.refresh_hydration_time_estimate::text AS hydration_time_estimate,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
 		mz_clusters.privileges
 	FROM mz_clusters
 	JOIN mz_roles
 		ON mz_clusters.owner_id = mz_roles.id
+	LEFT JOIN mz_internal.mz_cluster_schedules
+		ON mz_clusters.id = mz_cluster_schedules.cluster_id
 	LEFT JOIN (
 		SELECT id, comment
 		FROM mz_internal.mz_comments
@@ -200,10 +264,10 @@ var clusterQuery = NewBaseQuery(`
 		ON mz_clusters.id = comments.id`)
 
 func ClusterId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
-	q := clusterQuery.QueryPredicate(map[string]string{"mz_clusters.name": obj.Name})
+	q, args := clusterQuery.QueryPredicate(map[string]string{"mz_clusters.name": obj.Name})
 
 	var c ClusterParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -211,10 +275,10 @@ func ClusterId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 }
 
 func ScanCluster(conn *sqlx.DB, id string) (ClusterParams, error) {
-	q := clusterQuery.QueryPredicate(map[string]string{"mz_clusters.id": id})
+	q, args := clusterQuery.QueryPredicate(map[string]string{"mz_clusters.id": id})
 
 	var c ClusterParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -222,12 +286,21 @@ func ScanCluster(conn *sqlx.DB, id string) (ClusterParams, error) {
 }
 
 func ListClusters(conn *sqlx.DB) ([]ClusterParams, error) {
-	q := clusterQuery.QueryPredicate(map[string]string{})
+	q, args := clusterQuery.QueryPredicate(map[string]string{})
 
 	var c []ClusterParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
+
+// The cluster of the session
+func CurrentCluster(conn *sqlx.DB) (string, error) {
+	var name string
+	if err := getWithRetry(conn, &name, `SHOW CLUSTER;`); err != nil {
+		return "", err
+	}
+	return name, nil
+}
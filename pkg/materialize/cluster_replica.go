@@ -147,10 +147,10 @@ func ClusterReplicaId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 		"mz_cluster_replicas.name": obj.Name,
 		"mz_clusters.name":         obj.ClusterName,
 	}
-	q := clusterReplicaQuery.QueryPredicate(p)
+	q, args := clusterReplicaQuery.QueryPredicate(p)
 
 	var c ClusterReplicaParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -161,10 +161,10 @@ func ScanClusterReplica(conn *sqlx.DB, id string) (ClusterReplicaParams, error)
 	p := map[string]string{
 		"mz_cluster_replicas.id": id,
 	}
-	q := clusterReplicaQuery.QueryPredicate(p)
+	q, args := clusterReplicaQuery.QueryPredicate(p)
 
 	var c ClusterReplicaParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -173,10 +173,10 @@ func ScanClusterReplica(conn *sqlx.DB, id string) (ClusterReplicaParams, error)
 
 func ListClusterReplicas(conn *sqlx.DB) ([]ClusterReplicaParams, error) {
 	p := map[string]string{}
-	q := clusterReplicaQuery.QueryPredicate(p)
+	q, args := clusterReplicaQuery.QueryPredicate(p)
 
 	var c []ClusterReplicaParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -88,3 +88,56 @@ func TestClusterDrop(t *testing.T) {
 		}
 	})
 }
+
+func TestClusterManagedScheduleCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`CREATE CLUSTER "cluster" SIZE 'xsmall', SCHEDULE = ON REFRESH \(HYDRATION TIME ESTIMATE = '1 hour'\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "cluster"}
+		b := NewClusterBuilder(db, o)
+		b.Size("xsmall")
+		b.Scheduling(SchedulingStruct{OnRefresh: true, HydrationTimeEstimate: "1 hour"})
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestClusterResize(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SET \(SIZE 'small'\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "cluster"}
+		if err := NewClusterBuilder(db, o).Resize("small", WaitUntilReadyStruct{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestClusterResizeWaitUntilReady(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SET \(SIZE 'small'\) WITH \(WAIT UNTIL READY \(TIMEOUT = '10 minutes', ON TIMEOUT = 'ROLLBACK'\)\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "cluster"}
+		w := WaitUntilReadyStruct{Enabled: true, Timeout: "10 minutes", OnTimeout: "ROLLBACK"}
+		if err := NewClusterBuilder(db, o).Resize("small", w); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestClusterSetScheduling(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SET \(SCHEDULE = ON REFRESH\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SET \(SCHEDULE = MANUAL\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "cluster"}
+		b := NewClusterBuilder(db, o)
+		if err := b.SetScheduling(SchedulingStruct{OnRefresh: true}); err != nil {
+			t.Fatal(err)
+		}
+		if err := b.SetScheduling(SchedulingStruct{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
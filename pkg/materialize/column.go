@@ -36,10 +36,48 @@ var tableColumnQuery = NewBaseQuery(`
 
 func ListTableColumns(conn *sqlx.DB, objectId string) ([]TableColumnParams, error) {
 	p := map[string]string{"mz_columns.id": objectId}
-	q := tableColumnQuery.QueryPredicate(p)
+	q, args := tableColumnQuery.QueryPredicate(p)
 
 	var c []TableColumnParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+type RelationColumnParams struct {
+	Name     sql.NullString `db:"name"`
+	Position sql.NullString `db:"position"`
+	Nullable sql.NullBool   `db:"nullable"`
+	Type     sql.NullString `db:"type"`
+}
+
+var relationColumnQuery = NewBaseQuery(`
+	SELECT
+		mz_columns.name,
+		mz_columns.position,
+		mz_columns.nullable,
+		mz_columns.type
+	FROM mz_columns
+	JOIN mz_objects
+		ON mz_columns.id = mz_objects.id
+	JOIN mz_schemas
+		ON mz_objects.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id`).Order("mz_columns.position")
+
+// Lists the columns of a source, table, view or materialized view by name
+func ListRelationColumns(conn *sqlx.DB, obj IdentifierSchemaStruct) ([]RelationColumnParams, error) {
+	p := map[string]string{
+		"mz_objects.name":   obj.Name,
+		"mz_schemas.name":   obj.SchemaName,
+		"mz_databases.name": obj.DatabaseName,
+	}
+	q, args := relationColumnQuery.QueryPredicate(p)
+
+	var c []RelationColumnParams
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -80,10 +118,10 @@ func ListIndexColumns(conn *sqlx.DB, indexiId string) ([]IndexColumnParams, erro
 	p := map[string]string{
 		"mz_indexes.id": indexiId,
 	}
-	q := indexColumnQuery.QueryPredicate(p)
+	q, args := indexColumnQuery.QueryPredicate(p)
 
 	var c []IndexColumnParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -2,6 +2,9 @@ package materialize
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -23,6 +26,25 @@ func GetValueSecretStruct(databaseName string, schemaName string, v interface{})
 	return value
 }
 
+// The value of a connection option in an ALTER CONNECTION statement, either
+// free text or a reference to a secret. Empty when neither is set
+func (v ValueSecretStruct) OptionValue() string {
+	if v.Secret.Name != "" {
+		return fmt.Sprintf(`SECRET %s`, v.Secret.QualifiedName())
+	}
+	if v.Text != "" {
+		return QuoteString(v.Text)
+	}
+	return ""
+}
+
+// A single action of an ALTER CONNECTION statement. Options without a value
+// are reset to their default
+type ConnectionOption struct {
+	Name  string
+	Value string
+}
+
 type Connection struct {
 	ddl            Builder
 	ConnectionName string
@@ -48,6 +70,51 @@ func (b *Connection) Rename(newConnectionName string) error {
 	return b.ddl.rename(b.QualifiedName(), n)
 }
 
+// Sets or resets the options in place. Sources and sinks using the
+// connection pick up the new options without being recreated
+func (b *Connection) Alter(options []ConnectionOption, validate bool) error {
+	var a []string
+	for _, o := range options {
+		if o.Value == "" {
+			a = append(a, fmt.Sprintf(`RESET (%s)`, o.Name))
+		} else {
+			a = append(a, fmt.Sprintf(`SET (%s = %s)`, o.Name, o.Value))
+		}
+	}
+
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`ALTER CONNECTION %s %s`, b.QualifiedName(), strings.Join(a, ", ")))
+
+	if !validate {
+		q.WriteString(` WITH (VALIDATE = false)`)
+	}
+
+	q.WriteString(`;`)
+	return b.ddl.exec(q.String())
+}
+
+// Runs `VALIDATE CONNECTION`, which checks that Materialize can reach the
+// upstream system with the current options. Returns the error reported by
+// Materialize if the connection is not valid
+func (b *Connection) ValidateConnection() error {
+	q := fmt.Sprintf(`VALIDATE CONNECTION %s;`, b.QualifiedName())
+	return b.ddl.exec(q)
+}
+
+// SQLSTATE codes Materialize reports when the upstream system of a connection
+// cannot be reached or rejects the connection
+var connectionValidationCodes = map[string]bool{
+	"XX000": true, // internal_error
+	"58000": true, // system_error
+}
+
+// Reports whether VALIDATE CONNECTION failed because of the upstream system, as
+// opposed to an error running the statement such as a missing privilege
+func ConnectionValidationFailed(err error) bool {
+	var s sqlState
+	return errors.As(err, &s) && connectionValidationCodes[s.SQLState()]
+}
+
 func (b *Connection) Drop() error {
 	qn := b.QualifiedName()
 	return b.ddl.drop(qn)
@@ -62,6 +129,7 @@ type ConnectionParams struct {
 	Comment        sql.NullString `db:"comment"`
 	OwnerName      sql.NullString `db:"owner_name"`
 	Privileges     sql.NullString `db:"privileges"`
+	CreateSql      sql.NullString `db:"create_sql"`
 }
 
 var connectionQuery = NewBaseQuery(`
@@ -73,7 +141,8 @@ var connectionQuery = NewBaseQuery(`
 		mz_connections.type AS connection_type,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_connections.privileges
+		mz_connections.privileges,
+		mz_connections.create_sql
 	FROM mz_connections
 	JOIN mz_schemas
 		ON mz_connections.schema_id = mz_schemas.id
@@ -94,10 +163,10 @@ func ConnectionId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 		"mz_databases.name":   obj.DatabaseName,
 		"mz_schemas.name":     obj.SchemaName,
 	}
-	q := connectionQuery.QueryPredicate(p)
+	q, args := connectionQuery.QueryPredicate(p)
 
 	var c ConnectionParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -105,25 +174,25 @@ func ConnectionId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 }
 
 func ScanConnection(conn *sqlx.DB, id string) (ConnectionParams, error) {
-	q := connectionQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
+	q, args := connectionQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
 
 	var c ConnectionParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListConnections(conn *sqlx.DB, schemaName, databaseName string) ([]ConnectionParams, error) {
+func ListConnections(conn *sqlx.DB, schemaName, databaseName string, filters ...Predicate) ([]ConnectionParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
 	}
-	q := connectionQuery.QueryPredicate(p)
+	q, args := connectionQuery.Query(append(EqualPredicates(p), filters...)...)
 
 	var c []ConnectionParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -0,0 +1,157 @@
+package materialize
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ConnectionAwsBuilder struct {
+	Connection
+	endpoint              string
+	region                string
+	accessKeyId           ValueSecretStruct
+	secretAccessKey       IdentifierSchemaStruct
+	sessionToken          ValueSecretStruct
+	assumeRoleArn         string
+	assumeRoleSessionName string
+}
+
+func NewConnectionAwsBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionAwsBuilder {
+	b := Builder{conn, BaseConnection}
+	return &ConnectionAwsBuilder{
+		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
+	}
+}
+
+func (b *ConnectionAwsBuilder) Endpoint(endpoint string) *ConnectionAwsBuilder {
+	b.endpoint = endpoint
+	return b
+}
+
+func (b *ConnectionAwsBuilder) Region(region string) *ConnectionAwsBuilder {
+	b.region = region
+	return b
+}
+
+func (b *ConnectionAwsBuilder) AccessKeyId(accessKeyId ValueSecretStruct) *ConnectionAwsBuilder {
+	b.accessKeyId = accessKeyId
+	return b
+}
+
+func (b *ConnectionAwsBuilder) SecretAccessKey(secretAccessKey IdentifierSchemaStruct) *ConnectionAwsBuilder {
+	b.secretAccessKey = secretAccessKey
+	return b
+}
+
+func (b *ConnectionAwsBuilder) SessionToken(sessionToken ValueSecretStruct) *ConnectionAwsBuilder {
+	b.sessionToken = sessionToken
+	return b
+}
+
+func (b *ConnectionAwsBuilder) AssumeRoleArn(assumeRoleArn string) *ConnectionAwsBuilder {
+	b.assumeRoleArn = assumeRoleArn
+	return b
+}
+
+func (b *ConnectionAwsBuilder) AssumeRoleSessionName(assumeRoleSessionName string) *ConnectionAwsBuilder {
+	b.assumeRoleSessionName = assumeRoleSessionName
+	return b
+}
+
+func (b *ConnectionAwsBuilder) Create() error {
+	var o []string
+
+	if b.endpoint != "" {
+		o = append(o, fmt.Sprintf(`ENDPOINT = %s`, QuoteString(b.endpoint)))
+	}
+	if b.region != "" {
+		o = append(o, fmt.Sprintf(`REGION = %s`, QuoteString(b.region)))
+	}
+	if b.accessKeyId.Text != "" {
+		o = append(o, fmt.Sprintf(`ACCESS KEY ID = %s`, QuoteString(b.accessKeyId.Text)))
+	}
+	if b.accessKeyId.Secret.Name != "" {
+		o = append(o, fmt.Sprintf(`ACCESS KEY ID = SECRET %s`, b.accessKeyId.Secret.QualifiedName()))
+	}
+	if b.secretAccessKey.Name != "" {
+		o = append(o, fmt.Sprintf(`SECRET ACCESS KEY = SECRET %s`, b.secretAccessKey.QualifiedName()))
+	}
+	if b.sessionToken.Text != "" {
+		o = append(o, fmt.Sprintf(`SESSION TOKEN = %s`, QuoteString(b.sessionToken.Text)))
+	}
+	if b.sessionToken.Secret.Name != "" {
+		o = append(o, fmt.Sprintf(`SESSION TOKEN = SECRET %s`, b.sessionToken.Secret.QualifiedName()))
+	}
+	if b.assumeRoleArn != "" {
+		o = append(o, fmt.Sprintf(`ASSUME ROLE ARN = %s`, QuoteString(b.assumeRoleArn)))
+	}
+	if b.assumeRoleSessionName != "" {
+		o = append(o, fmt.Sprintf(`ASSUME ROLE SESSION NAME = %s`, QuoteString(b.assumeRoleSessionName)))
+	}
+
+	q := fmt.Sprintf(`CREATE CONNECTION %s TO AWS (%s);`, b.QualifiedName(), strings.Join(o, ", "))
+	return b.ddl.exec(q)
+}
+
+type ConnectionAwsParams struct {
+	ConnectionId          sql.NullString `db:"id"`
+	ConnectionName        sql.NullString `db:"connection_name"`
+	SchemaName            sql.NullString `db:"schema_name"`
+	DatabaseName          sql.NullString `db:"database_name"`
+	Endpoint              sql.NullString `db:"endpoint"`
+	Region                sql.NullString `db:"region"`
+	AccessKeyId           sql.NullString `db:"access_key_id"`
+	AssumeRoleArn         sql.NullString `db:"assume_role_arn"`
+	AssumeRoleSessionName sql.NullString `db:"assume_role_session_name"`
+	Principal             sql.NullString `db:"principal"`
+	ExternalId            sql.NullString `db:"external_id"`
+	ExampleTrustPolicy    sql.NullString `db:"example_trust_policy"`
+	Comment               sql.NullString `db:"comment"`
+	OwnerName             sql.NullString `db:"owner_name"`
+}
+
+var connectionAwsQuery = NewBaseQuery(`
+	SELECT
+		mz_connections.id,
+		mz_connections.name AS connection_name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_aws_connections.endpoint,
+		mz_aws_connections.region,
+		mz_aws_connections.access_key_id,
+		mz_aws_connections.assume_role_arn,
+		mz_aws_connections.assume_role_session_name,
+		mz_aws_connections.principal,
+		mz_aws_connections.external_id,
+		mz_aws_connections.example_trust_policy::text AS example_trust_policy,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name
+	FROM mz_connections
+	JOIN mz_schemas
+		ON mz_connections.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	LEFT JOIN mz_internal.mz_aws_connections
+		ON mz_connections.id = mz_aws_connections.id
+	JOIN mz_roles
+		ON mz_connections.owner_id = mz_roles.id
+	LEFT JOIN (
+		SELECT id, comment
+		FROM mz_internal.mz_comments
+		WHERE object_type = 'connection'
+	) comments
+		ON mz_connections.id = comments.id`)
+
+func ScanConnectionAws(conn *sqlx.DB, id string) (ConnectionAwsParams, error) {
+	q, args := connectionAwsQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
+
+	var c ConnectionAwsParams
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
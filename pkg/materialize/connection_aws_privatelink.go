@@ -50,13 +50,15 @@ func (b *ConnectionAwsPrivatelinkBuilder) Create() error {
 }
 
 type ConnectionAwsPrivatelinkParams struct {
-	ConnectionId   sql.NullString `db:"id"`
-	ConnectionName sql.NullString `db:"connection_name"`
-	SchemaName     sql.NullString `db:"schema_name"`
-	DatabaseName   sql.NullString `db:"database_name"`
-	Comment        sql.NullString `db:"comment"`
-	Principal      sql.NullString `db:"principal"`
-	OwnerName      sql.NullString `db:"owner_name"`
+	ConnectionId       sql.NullString `db:"id"`
+	ConnectionName     sql.NullString `db:"connection_name"`
+	SchemaName         sql.NullString `db:"schema_name"`
+	DatabaseName       sql.NullString `db:"database_name"`
+	Comment            sql.NullString `db:"comment"`
+	Principal          sql.NullString `db:"principal"`
+	Status             sql.NullString `db:"status"`
+	LastStatusChangeAt sql.NullString `db:"last_status_change_at"`
+	OwnerName          sql.NullString `db:"owner_name"`
 }
 
 var connectionAwsPrivatelinkQuery = NewBaseQuery(`
@@ -66,6 +68,8 @@ var connectionAwsPrivatelinkQuery = NewBaseQuery(`
 		mz_schemas.name AS schema_name,
 		mz_databases.name AS database_name,
 		mz_aws_privatelink_connections.principal,
+		mz_aws_privatelink_connection_statuses.status,
+		mz_aws_privatelink_connection_statuses.last_status_change_at,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name
 	FROM mz_connections
@@ -75,6 +79,8 @@ var connectionAwsPrivatelinkQuery = NewBaseQuery(`
 		ON mz_schemas.database_id = mz_databases.id
 	LEFT JOIN mz_aws_privatelink_connections
 		ON mz_connections.id = mz_aws_privatelink_connections.id
+	LEFT JOIN mz_internal.mz_aws_privatelink_connection_statuses
+		ON mz_connections.id = mz_aws_privatelink_connection_statuses.id
 	JOIN mz_roles
 		ON mz_connections.owner_id = mz_roles.id
 	LEFT JOIN (
@@ -85,10 +91,10 @@ var connectionAwsPrivatelinkQuery = NewBaseQuery(`
 		ON mz_connections.id = comments.id`)
 
 func ScanConnectionAwsPrivatelink(conn *sqlx.DB, id string) (ConnectionAwsPrivatelinkParams, error) {
-	q := connectionAwsPrivatelinkQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
+	q, args := connectionAwsPrivatelinkQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
 
 	var c ConnectionAwsPrivatelinkParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
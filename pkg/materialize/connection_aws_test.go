@@ -0,0 +1,47 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestConnectionAwsAssumeRoleCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE CONNECTION "database"."schema"."aws_conn" TO AWS \(REGION = 'us-east-1', ASSUME ROLE ARN = 'arn:aws:iam::000000000000:role/materialize', ASSUME ROLE SESSION NAME = 'materialize'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "aws_conn", SchemaName: "schema", DatabaseName: "database"}
+		b := NewConnectionAwsBuilder(db, o)
+		b.Region("us-east-1")
+		b.AssumeRoleArn("arn:aws:iam::000000000000:role/materialize")
+		b.AssumeRoleSessionName("materialize")
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestConnectionAwsAccessKeyCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE CONNECTION "database"."schema"."aws_conn" TO AWS \(ENDPOINT = 'http://localhost:4566', REGION = 'us-east-1', ACCESS KEY ID = 'AKIA0000', SECRET ACCESS KEY = SECRET "database"."schema"."secret_key", SESSION TOKEN = SECRET "database"."schema"."token"\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "aws_conn", SchemaName: "schema", DatabaseName: "database"}
+		b := NewConnectionAwsBuilder(db, o)
+		b.Endpoint("http://localhost:4566")
+		b.Region("us-east-1")
+		b.AccessKeyId(ValueSecretStruct{Text: "AKIA0000"})
+		b.SecretAccessKey(IdentifierSchemaStruct{Name: "secret_key", SchemaName: "schema", DatabaseName: "database"})
+		b.SessionToken(ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "token", SchemaName: "schema", DatabaseName: "database"}})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
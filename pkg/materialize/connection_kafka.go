@@ -32,6 +32,35 @@ func GetKafkaBrokersStruct(databaseName, schemaName string, v interface{}) []Kaf
 	return brokers
 }
 
+// The list of brokers, each reached through the SSH tunnel or its AWS
+// PrivateLink connection when set
+func KafkaBrokersOptionValue(brokers []KafkaBroker, sshTunnel IdentifierSchemaStruct) string {
+	q := strings.Builder{}
+	q.WriteString(`(`)
+	if sshTunnel.Name != "" {
+		for i, broker := range brokers {
+			q.WriteString(fmt.Sprintf(`%s USING SSH TUNNEL %s`, QuoteString(broker.Broker), sshTunnel.QualifiedName()))
+			if i < len(brokers)-1 {
+				q.WriteString(`,`)
+			}
+		}
+	} else {
+		for i, broker := range brokers {
+			if broker.TargetGroupPort != 0 && broker.AvailabilityZone != "" && broker.PrivateLinkConnection.Name != "" {
+				q.WriteString(fmt.Sprintf(`%s USING AWS PRIVATELINK %s (PORT %d, AVAILABILITY ZONE %s)`, QuoteString(broker.Broker),
+					broker.PrivateLinkConnection.QualifiedName(), broker.TargetGroupPort, QuoteString(broker.AvailabilityZone)))
+			} else {
+				q.WriteString(QuoteString(broker.Broker))
+			}
+			if i < len(brokers)-1 {
+				q.WriteString(`, `)
+			}
+		}
+	}
+	q.WriteString(`)`)
+	return q.String()
+}
+
 type ConnectionKafkaBuilder struct {
 	Connection
 	kafkaBrokers        []KafkaBroker
@@ -43,6 +72,7 @@ type ConnectionKafkaBuilder struct {
 	kafkaSASLUsername   ValueSecretStruct
 	kafkaSASLPassword   IdentifierSchemaStruct
 	kafkaSSHTunnel      IdentifierSchemaStruct
+	kafkaAwsConnection  IdentifierSchemaStruct
 	validate            bool
 }
 
@@ -98,6 +128,11 @@ func (b *ConnectionKafkaBuilder) KafkaSSHTunnel(kafkaSSHTunnel IdentifierSchemaS
 	return b
 }
 
+func (b *ConnectionKafkaBuilder) KafkaAwsConnection(kafkaAwsConnection IdentifierSchemaStruct) *ConnectionKafkaBuilder {
+	b.kafkaAwsConnection = kafkaAwsConnection
+	return b
+}
+
 func (b *ConnectionKafkaBuilder) Validate(validate bool) *ConnectionKafkaBuilder {
 	b.validate = validate
 	return b
@@ -107,33 +142,7 @@ func (b *ConnectionKafkaBuilder) Create() error {
 	q := strings.Builder{}
 	q.WriteString(fmt.Sprintf(`CREATE CONNECTION %s TO KAFKA (`, b.QualifiedName()))
 
-	if b.kafkaSSHTunnel.Name != "" {
-		q.WriteString(`BROKERS (`)
-		for i, broker := range b.kafkaBrokers {
-			q.WriteString(fmt.Sprintf(`%s USING SSH TUNNEL %s`, QuoteString(broker.Broker), QualifiedName(b.kafkaSSHTunnel.DatabaseName, b.kafkaSSHTunnel.SchemaName, b.kafkaSSHTunnel.Name)))
-			if i < len(b.kafkaBrokers)-1 {
-				q.WriteString(`,`)
-			}
-		}
-		q.WriteString(`)`)
-	} else {
-		q.WriteString(`BROKERS (`)
-		for i, broker := range b.kafkaBrokers {
-			if broker.TargetGroupPort != 0 && broker.AvailabilityZone != "" && broker.PrivateLinkConnection.Name != "" {
-				q.WriteString(fmt.Sprintf(`%s USING AWS PRIVATELINK %s (PORT %d, AVAILABILITY ZONE %s)`, QuoteString(broker.Broker),
-					QualifiedName(broker.PrivateLinkConnection.DatabaseName, broker.PrivateLinkConnection.SchemaName, broker.PrivateLinkConnection.Name), broker.TargetGroupPort, QuoteString(broker.AvailabilityZone)))
-				if i < len(b.kafkaBrokers)-1 {
-					q.WriteString(`, `)
-				}
-			} else {
-				q.WriteString(QuoteString(broker.Broker))
-				if i < len(b.kafkaBrokers)-1 {
-					q.WriteString(`, `)
-				}
-			}
-		}
-		q.WriteString(`)`)
-	}
+	q.WriteString(fmt.Sprintf(`BROKERS %s`, KafkaBrokersOptionValue(b.kafkaBrokers, b.kafkaSSHTunnel)))
 
 	if b.kafkaProgressTopic != "" {
 		q.WriteString(fmt.Sprintf(`, PROGRESS TOPIC %s`, QuoteString(b.kafkaProgressTopic)))
@@ -165,6 +174,9 @@ func (b *ConnectionKafkaBuilder) Create() error {
 	if b.kafkaSASLPassword.Name != "" {
 		q.WriteString(fmt.Sprintf(`, SASL PASSWORD = SECRET %s`, b.kafkaSASLPassword.QualifiedName()))
 	}
+	if b.kafkaAwsConnection.Name != "" {
+		q.WriteString(fmt.Sprintf(`, AWS CONNECTION = %s`, b.kafkaAwsConnection.QualifiedName()))
+	}
 
 	q.WriteString(`)`)
 
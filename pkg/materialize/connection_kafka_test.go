@@ -197,3 +197,16 @@ func TestConnectionKafkaAwsPrivatelinkCreate(t *testing.T) {
 	})
 
 }
+
+func TestKafkaBrokersOptionValue(t *testing.T) {
+	brokers := []KafkaBroker{{Broker: "b-1.hostname-1:9096"}, {Broker: "b-2.hostname-2:9096"}}
+
+	if v := KafkaBrokersOptionValue(brokers, IdentifierSchemaStruct{}); v != `('b-1.hostname-1:9096', 'b-2.hostname-2:9096')` {
+		t.Fatalf("unexpected brokers: %s", v)
+	}
+
+	tunnel := IdentifierSchemaStruct{Name: "tunnel", SchemaName: "schema", DatabaseName: "database"}
+	if v := KafkaBrokersOptionValue(brokers[:1], tunnel); v != `('b-1.hostname-1:9096' USING SSH TUNNEL "database"."schema"."tunnel")` {
+		t.Fatalf("unexpected brokers: %s", v)
+	}
+}
@@ -0,0 +1,135 @@
+package materialize
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ConnectionMysqlBuilder struct {
+	Connection
+	mysqlHost           string
+	mysqlPort           int
+	mysqlUser           ValueSecretStruct
+	mysqlPassword       IdentifierSchemaStruct
+	mysqlSSHTunnel      IdentifierSchemaStruct
+	mysqlSSLCa          ValueSecretStruct
+	mysqlSSLCert        ValueSecretStruct
+	mysqlSSLKey         IdentifierSchemaStruct
+	mysqlSSLMode        string
+	mysqlAWSPrivateLink IdentifierSchemaStruct
+	validate            bool
+}
+
+func NewConnectionMysqlBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionMysqlBuilder {
+	b := Builder{conn, BaseConnection}
+	return &ConnectionMysqlBuilder{
+		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
+	}
+}
+
+func (b *ConnectionMysqlBuilder) MysqlHost(mysqlHost string) *ConnectionMysqlBuilder {
+	b.mysqlHost = mysqlHost
+	return b
+}
+
+func (b *ConnectionMysqlBuilder) MysqlPort(mysqlPort int) *ConnectionMysqlBuilder {
+	b.mysqlPort = mysqlPort
+	return b
+}
+
+func (b *ConnectionMysqlBuilder) MysqlUser(mysqlUser ValueSecretStruct) *ConnectionMysqlBuilder {
+	b.mysqlUser = mysqlUser
+	return b
+}
+
+func (b *ConnectionMysqlBuilder) MysqlPassword(mysqlPassword IdentifierSchemaStruct) *ConnectionMysqlBuilder {
+	b.mysqlPassword = mysqlPassword
+	return b
+}
+
+func (b *ConnectionMysqlBuilder) MysqlSSHTunnel(mysqlSSHTunnel IdentifierSchemaStruct) *ConnectionMysqlBuilder {
+	b.mysqlSSHTunnel = mysqlSSHTunnel
+	return b
+}
+
+func (b *ConnectionMysqlBuilder) MysqlSSLCa(mysqlSSLCa ValueSecretStruct) *ConnectionMysqlBuilder {
+	b.mysqlSSLCa = mysqlSSLCa
+	return b
+}
+
+func (b *ConnectionMysqlBuilder) MysqlSSLCert(mysqlSSLCert ValueSecretStruct) *ConnectionMysqlBuilder {
+	b.mysqlSSLCert = mysqlSSLCert
+	return b
+}
+
+func (b *ConnectionMysqlBuilder) MysqlSSLKey(mysqlSSLKey IdentifierSchemaStruct) *ConnectionMysqlBuilder {
+	b.mysqlSSLKey = mysqlSSLKey
+	return b
+}
+
+func (b *ConnectionMysqlBuilder) MysqlSSLMode(mysqlSSLMode string) *ConnectionMysqlBuilder {
+	b.mysqlSSLMode = mysqlSSLMode
+	return b
+}
+
+func (b *ConnectionMysqlBuilder) MysqlAWSPrivateLink(mysqlAWSPrivateLink IdentifierSchemaStruct) *ConnectionMysqlBuilder {
+	b.mysqlAWSPrivateLink = mysqlAWSPrivateLink
+	return b
+}
+
+func (b *ConnectionMysqlBuilder) Validate(validate bool) *ConnectionMysqlBuilder {
+	b.validate = validate
+	return b
+}
+
+func (b *ConnectionMysqlBuilder) Create() error {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE CONNECTION %s TO MYSQL (`, b.QualifiedName()))
+
+	q.WriteString(fmt.Sprintf(`HOST %s`, QuoteString(b.mysqlHost)))
+	q.WriteString(fmt.Sprintf(`, PORT %d`, b.mysqlPort))
+	if b.mysqlUser.Text != "" {
+		q.WriteString(fmt.Sprintf(`, USER %s`, QuoteString(b.mysqlUser.Text)))
+	}
+	if b.mysqlUser.Secret.Name != "" {
+		q.WriteString(fmt.Sprintf(`, USER SECRET %s`, b.mysqlUser.Secret.QualifiedName()))
+	}
+	if b.mysqlPassword.Name != "" {
+		q.WriteString(fmt.Sprintf(`, PASSWORD SECRET %s`, b.mysqlPassword.QualifiedName()))
+	}
+	if b.mysqlSSLMode != "" {
+		q.WriteString(fmt.Sprintf(`, SSL MODE %s`, QuoteString(b.mysqlSSLMode)))
+	}
+	if b.mysqlSSHTunnel.Name != "" {
+		q.WriteString(fmt.Sprintf(`, SSH TUNNEL %s`, b.mysqlSSHTunnel.QualifiedName()))
+	}
+	if b.mysqlSSLCa.Text != "" {
+		q.WriteString(fmt.Sprintf(`, SSL CERTIFICATE AUTHORITY %s`, QuoteString(b.mysqlSSLCa.Text)))
+	}
+	if b.mysqlSSLCa.Secret.Name != "" {
+		q.WriteString(fmt.Sprintf(`, SSL CERTIFICATE AUTHORITY SECRET %s`, b.mysqlSSLCa.Secret.QualifiedName()))
+	}
+	if b.mysqlSSLCert.Text != "" {
+		q.WriteString(fmt.Sprintf(`, SSL CERTIFICATE %s`, QuoteString(b.mysqlSSLCert.Text)))
+	}
+	if b.mysqlSSLCert.Secret.Name != "" {
+		q.WriteString(fmt.Sprintf(`, SSL CERTIFICATE SECRET %s`, b.mysqlSSLCert.Secret.QualifiedName()))
+	}
+	if b.mysqlSSLKey.Name != "" {
+		q.WriteString(fmt.Sprintf(`, SSL KEY SECRET %s`, b.mysqlSSLKey.QualifiedName()))
+	}
+	if b.mysqlAWSPrivateLink.Name != "" {
+		q.WriteString(fmt.Sprintf(`, AWS PRIVATELINK %s`, b.mysqlAWSPrivateLink.QualifiedName()))
+	}
+
+	q.WriteString(`)`)
+
+	if !b.validate {
+		q.WriteString(` WITH (VALIDATE = false)`)
+	}
+
+	q.WriteString(`;`)
+	return b.ddl.exec(q.String())
+}
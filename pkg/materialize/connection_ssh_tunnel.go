@@ -47,6 +47,13 @@ func (b *ConnectionSshTunnelBuilder) Create() error {
 	return b.ddl.exec(q.String())
 }
 
+// Generates a new key pair for the tunnel. The previous primary key becomes
+// the secondary key so the bastion can be updated before the old key is removed
+func (b *ConnectionSshTunnelBuilder) RotateKeys() error {
+	q := fmt.Sprintf(`ALTER CONNECTION %s ROTATE KEYS;`, b.QualifiedName())
+	return b.ddl.exec(q)
+}
+
 type ConnectionSshTunnelParams struct {
 	ConnectionId   sql.NullString `db:"id"`
 	ConnectionName sql.NullString `db:"connection_name"`
@@ -85,10 +92,10 @@ var connectionSshTunnelQuery = NewBaseQuery(`
 		ON mz_connections.id = comments.id`)
 
 func ScanConnectionSshTunnel(conn *sqlx.DB, id string) (ConnectionSshTunnelParams, error) {
-	q := connectionSshTunnelQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
+	q, args := connectionSshTunnelQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
 
 	var c ConnectionSshTunnelParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -25,3 +25,16 @@ func TestConnectionSshTunnelCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestConnectionSshTunnelRotateKeys(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."ssh_conn" ROTATE KEYS;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "ssh_conn", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewConnectionSshTunnelBuilder(db, o).RotateKeys(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package materialize
+
+import (
+	"fmt"
+	"syscall"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+var connAlter = MaterializeObject{Name: "conn", SchemaName: "schema", DatabaseName: "database"}
+
+func TestConnectionAlter(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."conn" SET \(HOST = 'postgres_host'\), SET \(PASSWORD = SECRET "database"."schema"."password"\), RESET \(SSH TUNNEL\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		password := IdentifierSchemaStruct{Name: "password", SchemaName: "schema", DatabaseName: "database"}
+		o := []ConnectionOption{
+			{Name: "HOST", Value: QuoteString("postgres_host")},
+			{Name: "PASSWORD", Value: password.SecretOptionValue()},
+			{Name: "SSH TUNNEL", Value: IdentifierSchemaStruct{}.OptionValue()},
+		}
+
+		if err := NewConnection(db, connAlter).Alter(o, true); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestConnectionAlterNoValidate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."conn" SET \(USER = SECRET "database"."schema"."user"\) WITH \(VALIDATE = false\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		user := ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "user", SchemaName: "schema", DatabaseName: "database"}}
+		o := []ConnectionOption{{Name: "USER", Value: user.OptionValue()}}
+
+		if err := NewConnection(db, connAlter).Alter(o, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestConnectionValidate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`VALIDATE CONNECTION "database"."schema"."conn";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewConnection(db, connAlter).ValidateConnection(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestConnectionValidationFailed(t *testing.T) {
+	for _, tc := range []struct {
+		err      error
+		expected bool
+	}{
+		{testSQLStateError{"XX000"}, true},
+		{fmt.Errorf("wrapped: %w", testSQLStateError{"58000"}), true},
+		{testSQLStateError{"42501"}, false},
+		{testSQLStateError{"08006"}, false},
+		{syscall.ECONNRESET, false},
+		{nil, false},
+	} {
+		if r := ConnectionValidationFailed(tc.err); r != tc.expected {
+			t.Errorf("ConnectionValidationFailed(%v) = %v, expected %v", tc.err, r, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,1167 @@
+package materialize
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The value of an option in a `create_sql` definition
+type OptionValue struct {
+	// String literals, numbers and names
+	Text string
+	// Objects and secrets referenced by the option
+	Object IdentifierSchemaStruct
+	Secret bool
+	// Parenthesized or bracketed lists
+	Values []OptionValue
+}
+
+// Options keyed by their lower case name, e.g. `ssl certificate`
+type Options map[string]OptionValue
+
+// A connection as described by its `create_sql`
+type ConnectionDefinition struct {
+	// The lower case connection type, e.g. `confluent schema registry`
+	Type    string
+	Options Options
+	Brokers []KafkaBroker
+	With    Options
+}
+
+// A source as described by its `create_sql`
+type SourceDefinition struct {
+	// The lower case source type, e.g. `kafka` or `load generator`
+	Type          string
+	Connection    IdentifierSchemaStruct
+	LoadGenerator string
+	Options       Options
+	// Tables and schemas of a FOR TABLES or FOR SCHEMAS clause
+	Tables      []TableStruct
+	Schemas     []string
+	Format      SourceFormatSpecStruct
+	KeyFormat   SourceFormatSpecStruct
+	ValueFormat SourceFormatSpecStruct
+	// Included metadata keyed by the lower case item with its alias
+	Include         map[string]string
+	IncludeHeader   []HeaderStruct
+	IncludeHeaders  IncludeHeadersStruct
+	Envelope        KafkaSourceEnvelopeStruct
+	ExposeProgress  IdentifierSchemaStruct
+	BodyFormat      string
+	CheckOptions    []CheckOptionsStruct
+	CheckExpression string
+	With            Options
+}
+
+// A Kafka sink as described by its `create_sql`
+type SinkDefinition struct {
+	From           IdentifierSchemaStruct
+	Connection     IdentifierSchemaStruct
+	Options        Options
+	TopicConfig    map[string]string
+	Key            []string
+	KeyNotEnforced bool
+	Headers        string
+	Format         SinkFormatSpecStruct
+	KeyFormat      SinkFormatSpecStruct
+	ValueFormat    SinkFormatSpecStruct
+	Envelope       KafkaSinkEnvelopeStruct
+	With           Options
+}
+
+// A subsource as described by its `create_sql`
+type SubsourceDefinition struct {
+	Source            IdentifierSchemaStruct
+	ExternalReference []string
+	Options           Options
+}
+
+// Walks the tokens of a `create_sql` definition
+type definitionParser struct {
+	t []token
+	i int
+}
+
+func newDefinitionParser(createSql string) *definitionParser {
+	t := tokenize(createSql)
+	for len(t) > 0 && t[len(t)-1].text == ";" {
+		t = t[:len(t)-1]
+	}
+	return &definitionParser{t: t}
+}
+
+func (p *definitionParser) done() bool {
+	return p.i >= len(p.t)
+}
+
+func (p *definitionParser) errorf(format string, a ...interface{}) error {
+	near := "end of definition"
+	if !p.done() {
+		near = p.t[p.i].text
+	}
+	return fmt.Errorf("unable to parse definition near %s: %s", near, fmt.Sprintf(format, a...))
+}
+
+// Reports whether the next tokens are the space separated keywords
+func (p *definitionParser) peek(keywords string) bool {
+	for j, k := range strings.Fields(keywords) {
+		if p.i+j >= len(p.t) || p.t[p.i+j].kind != tokenWord || p.t[p.i+j].text != k {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *definitionParser) accept(keywords string) bool {
+	if !p.peek(keywords) {
+		return false
+	}
+	p.i += len(strings.Fields(keywords))
+	return true
+}
+
+func (p *definitionParser) expect(keywords string) error {
+	if !p.accept(keywords) {
+		return p.errorf("expected %s", strings.ToUpper(keywords))
+	}
+	return nil
+}
+
+func (p *definitionParser) peekSymbol(s string) bool {
+	return !p.done() && p.t[p.i].kind == tokenSymbol && p.t[p.i].text == s
+}
+
+func (p *definitionParser) acceptSymbol(s string) bool {
+	if !p.peekSymbol(s) {
+		return false
+	}
+	p.i++
+	return true
+}
+
+func (p *definitionParser) expectSymbol(s string) error {
+	if !p.acceptSymbol(s) {
+		return p.errorf("expected %s", s)
+	}
+	return nil
+}
+
+// Returns the value of a string literal
+func (p *definitionParser) str() (string, error) {
+	if p.done() || p.t[p.i].kind != tokenString {
+		return "", p.errorf("expected a string")
+	}
+	s := p.t[p.i].text
+	p.i++
+	return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+}
+
+// Returns the words up to the next symbol or keyword that ends them
+func (p *definitionParser) words(stop ...string) string {
+	var w []string
+	for !p.done() && p.t[p.i].kind == tokenWord {
+		for _, s := range stop {
+			if p.peek(s) {
+				return strings.Join(w, " ")
+			}
+		}
+		w = append(w, p.t[p.i].text)
+		p.i++
+	}
+	return strings.Join(w, " ")
+}
+
+func unquoteIdentifier(t token) string {
+	if t.kind == tokenIdent && strings.HasPrefix(t.text, `"`) {
+		return strings.ReplaceAll(t.text[1:len(t.text)-1], `""`, `"`)
+	}
+	return t.text
+}
+
+// Returns the parts of a dotted name
+func (p *definitionParser) name() ([]string, error) {
+	var parts []string
+	for {
+		if p.done() || !isName(p.t[p.i]) {
+			return nil, p.errorf("expected a name")
+		}
+		parts = append(parts, unquoteIdentifier(p.t[p.i]))
+		p.i++
+		if !p.acceptSymbol(".") {
+			return parts, nil
+		}
+	}
+}
+
+func identifierFromParts(n []string) IdentifierSchemaStruct {
+	var o IdentifierSchemaStruct
+	o.Name = n[len(n)-1]
+	if len(n) > 1 {
+		o.SchemaName = n[len(n)-2]
+	}
+	if len(n) > 2 {
+		o.DatabaseName = n[len(n)-3]
+	}
+	return o
+}
+
+// Returns an object that is either resolved, `[u1 AS "db"."schema"."name"]`,
+// or named. Resolved references without a name, such as clusters, are empty
+func (p *definitionParser) object() (IdentifierSchemaStruct, error) {
+	if !p.acceptSymbol("[") {
+		n, err := p.name()
+		if err != nil {
+			return IdentifierSchemaStruct{}, err
+		}
+		return identifierFromParts(n), nil
+	}
+
+	if p.done() {
+		return IdentifierSchemaStruct{}, p.errorf("expected an object id")
+	}
+	p.i++
+	if p.acceptSymbol("]") {
+		return IdentifierSchemaStruct{}, nil
+	}
+	if err := p.expect("as"); err != nil {
+		return IdentifierSchemaStruct{}, err
+	}
+	n, err := p.name()
+	if err != nil {
+		return IdentifierSchemaStruct{}, err
+	}
+	return identifierFromParts(n), p.expectSymbol("]")
+}
+
+// Returns the tokens of an expression up to the next top level comma or
+// closing parenthesis, formatted as SQL
+func (p *definitionParser) expression() string {
+	var t []token
+	depth := 0
+	for !p.done() {
+		c := p.t[p.i]
+		if c.kind == tokenSymbol {
+			switch c.text {
+			case "(", "[":
+				depth++
+			case ")", "]":
+				if depth == 0 {
+					return joinTokens(t)
+				}
+				depth--
+			case ",":
+				if depth == 0 {
+					return joinTokens(t)
+				}
+			}
+		}
+		t = append(t, c)
+		p.i++
+	}
+	return joinTokens(t)
+}
+
+func joinTokens(t []token) string {
+	var s strings.Builder
+	for i, c := range t {
+		if i > 0 {
+			prev := t[i-1].text
+			tight := c.text == "," || c.text == ")" || c.text == "]" || c.text == "." || c.text == "::" || prev == "(" || prev == "[" || prev == "." || prev == "::"
+			// Function calls and subscripts
+			if (c.text == "(" || c.text == "[") && isName(t[i-1]) {
+				tight = true
+			}
+			if !tight {
+				s.WriteString(" ")
+			}
+		}
+		s.WriteString(c.text)
+	}
+	return s.String()
+}
+
+// Returns a literal, name, object reference or list of values
+func (p *definitionParser) value() (OptionValue, error) {
+	switch {
+	case p.done():
+		return OptionValue{}, p.errorf("expected a value")
+
+	case p.accept("secret"):
+		o, err := p.object()
+		return OptionValue{Object: o, Secret: true}, err
+
+	case p.peekSymbol("["):
+		o, err := p.object()
+		return OptionValue{Object: o}, err
+
+	case p.peekSymbol("(") || p.peek("array"):
+		p.accept("array")
+		closing := ")"
+		if !p.acceptSymbol("(") {
+			if err := p.expectSymbol("["); err != nil {
+				return OptionValue{}, err
+			}
+			closing = "]"
+		}
+		var v OptionValue
+		v.Values = []OptionValue{}
+		for !p.acceptSymbol(closing) {
+			e, err := p.value()
+			if err != nil {
+				return v, err
+			}
+			v.Values = append(v.Values, e)
+			if !p.acceptSymbol(",") && !p.peekSymbol(closing) {
+				return v, p.errorf("expected , or %s", closing)
+			}
+		}
+		return v, nil
+
+	case p.t[p.i].kind == tokenString:
+		s, err := p.str()
+		return OptionValue{Text: s}, err
+
+	case p.acceptSymbol("-"):
+		v, err := p.value()
+		v.Text = "-" + v.Text
+		return v, err
+
+	case isName(p.t[p.i]):
+		// Numbers with a fraction are tokenized as dotted names
+		start := p.i
+		n, err := p.name()
+		if err != nil || p.done() || p.peekSymbol(",") || p.peekSymbol(")") || p.peekSymbol("]") {
+			return OptionValue{Text: strings.Join(n, ".")}, err
+		}
+		p.i = start
+	}
+
+	return OptionValue{Text: p.expression()}, nil
+}
+
+// Returns the lower case name of an option, the words before its value
+func (p *definitionParser) optionName() string {
+	var w []string
+	// Numbers are values of options without an equals sign
+	for !p.done() && p.t[p.i].kind == tokenWord && !unicode.IsDigit(rune(p.t[p.i].text[0])) {
+		w = append(w, p.t[p.i].text)
+		p.i++
+	}
+	return strings.Join(w, " ")
+}
+
+// Calls value for the name of each option in a parenthesized list. The
+// callback is positioned at the value of the option
+func (p *definitionParser) eachOption(value func(name string) error) error {
+	if err := p.expectSymbol("("); err != nil {
+		return err
+	}
+	for !p.acceptSymbol(")") {
+		name := p.optionName()
+		if name == "" {
+			return p.errorf("expected an option")
+		}
+		if err := value(name); err != nil {
+			return err
+		}
+		if !p.acceptSymbol(",") && !p.peekSymbol(")") {
+			return p.errorf("expected , or )")
+		}
+	}
+	return nil
+}
+
+// Returns the values of a parenthesized list of options. Options without a
+// value are true
+func (p *definitionParser) options() (Options, error) {
+	o := Options{}
+	err := p.eachOption(func(name string) error {
+		if p.peekSymbol(",") || p.peekSymbol(")") {
+			o[name] = OptionValue{Text: "true"}
+			return nil
+		}
+		p.acceptSymbol("=")
+		v, err := p.value()
+		o[name] = v
+		return err
+	})
+	return o, err
+}
+
+// Skips a balanced parenthesized list
+func (p *definitionParser) skipParenthesized() error {
+	if err := p.expectSymbol("("); err != nil {
+		return err
+	}
+	for depth := 1; depth > 0; p.i++ {
+		if p.done() {
+			return p.errorf("expected )")
+		}
+		switch p.t[p.i].text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		}
+	}
+	return nil
+}
+
+// Skips the name and optional cluster of a CREATE statement
+func (p *definitionParser) header(object string) error {
+	if err := p.expect("create " + object); err != nil {
+		return err
+	}
+	if _, err := p.name(); err != nil {
+		return err
+	}
+	if p.accept("in cluster") {
+		if _, err := p.object(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *definitionParser) with() (Options, error) {
+	if !p.accept("with") {
+		return Options{}, nil
+	}
+	return p.options()
+}
+
+func ParseConnectionDefinition(createSql string) (ConnectionDefinition, error) {
+	p := newDefinitionParser(createSql)
+	c := ConnectionDefinition{Options: Options{}}
+
+	if err := p.header("connection"); err != nil {
+		return c, err
+	}
+	if err := p.expect("to"); err != nil {
+		return c, err
+	}
+	c.Type = p.words()
+
+	err := p.eachOption(func(name string) error {
+		p.acceptSymbol("=")
+		if name != "broker" && name != "brokers" {
+			v, err := p.value()
+			c.Options[name] = v
+			return err
+		}
+
+		if !p.acceptSymbol("(") {
+			return p.broker(&c)
+		}
+		for !p.acceptSymbol(")") {
+			if err := p.broker(&c); err != nil {
+				return err
+			}
+			if !p.acceptSymbol(",") && !p.peekSymbol(")") {
+				return p.errorf("expected , or )")
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return c, err
+	}
+
+	if c.With, err = p.with(); err != nil {
+		return c, err
+	}
+	if !p.done() {
+		return c, p.errorf("unexpected clause")
+	}
+	return c, nil
+}
+
+// Parses a Kafka broker. Brokers that use an SSH tunnel set the tunnel of
+// the connection
+func (p *definitionParser) broker(c *ConnectionDefinition) error {
+	s, err := p.str()
+	if err != nil {
+		return err
+	}
+	b := KafkaBroker{Broker: s}
+
+	switch {
+	case p.accept("using ssh tunnel"):
+		o, err := p.object()
+		if err != nil {
+			return err
+		}
+		c.Options["ssh tunnel"] = OptionValue{Object: o}
+
+	case p.accept("using aws privatelink"):
+		if b.PrivateLinkConnection, err = p.object(); err != nil {
+			return err
+		}
+		if p.peekSymbol("(") {
+			o, err := p.options()
+			if err != nil {
+				return err
+			}
+			if port, ok := o["port"]; ok {
+				if b.TargetGroupPort, err = strconv.Atoi(port.Text); err != nil {
+					return err
+				}
+			}
+			b.AvailabilityZone = o["availability zone"].Text
+		}
+	}
+
+	c.Brokers = append(c.Brokers, b)
+	return nil
+}
+
+func ParseSourceDefinition(createSql string) (SourceDefinition, error) {
+	p := newDefinitionParser(createSql)
+	s := SourceDefinition{Options: Options{}, Include: map[string]string{}, With: Options{}}
+
+	if err := p.header("source"); err != nil {
+		return s, err
+	}
+	// Column names of the source
+	if p.peekSymbol("(") {
+		if err := p.skipParenthesized(); err != nil {
+			return s, err
+		}
+	}
+	if err := p.expect("from"); err != nil {
+		return s, err
+	}
+
+	var err error
+	switch {
+	case p.accept("load generator"):
+		s.Type = "load generator"
+		s.LoadGenerator = p.words("for", "expose", "with")
+		if p.peekSymbol("(") {
+			s.Options, err = p.options()
+		}
+
+	case p.accept("webhook body format"):
+		s.Type = "webhook"
+		s.BodyFormat = strings.ToUpper(p.words("include", "check", "with"))
+
+	default:
+		s.Type = p.words("connection")
+		if err := p.expect("connection"); err != nil {
+			return s, err
+		}
+		if s.Connection, err = p.object(); err != nil {
+			return s, err
+		}
+		if p.peekSymbol("(") {
+			s.Options, err = p.options()
+		}
+	}
+	if err != nil {
+		return s, err
+	}
+
+	for !p.done() {
+		switch {
+		case p.accept("for all tables"):
+
+		case p.accept("for tables"):
+			err = p.list(func() error {
+				n, err := p.name()
+				if err != nil {
+					return err
+				}
+				t := TableStruct{Name: strings.Join(n, ".")}
+				if p.accept("as") {
+					a, err := p.name()
+					if err != nil {
+						return err
+					}
+					t.Alias = strings.Join(a, ".")
+				}
+				s.Tables = append(s.Tables, t)
+				return nil
+			})
+
+		case p.accept("for schemas"):
+			err = p.list(func() error {
+				n, err := p.name()
+				s.Schemas = append(s.Schemas, strings.Join(n, "."))
+				return err
+			})
+
+		case p.accept("key format"):
+			s.KeyFormat, err = p.sourceFormat()
+
+		case p.accept("value format"):
+			s.ValueFormat, err = p.sourceFormat()
+
+		case p.accept("format"):
+			s.Format, err = p.sourceFormat()
+
+		case p.accept("include"):
+			err = p.include(&s)
+
+		case p.accept("envelope"):
+			switch {
+			case p.accept("none"):
+				s.Envelope.None = true
+			case p.accept("debezium"):
+				s.Envelope.Debezium = true
+			case p.accept("upsert"):
+				s.Envelope.Upsert = true
+			default:
+				return s, p.errorf("unsupported envelope")
+			}
+			if p.peekSymbol("(") {
+				err = p.skipParenthesized()
+			}
+
+		case p.accept("check"):
+			err = p.check(&s)
+
+		case p.accept("expose progress as"):
+			s.ExposeProgress, err = p.object()
+
+		case p.peek("with"):
+			s.With, err = p.with()
+
+		default:
+			return s, p.errorf("unsupported clause")
+		}
+
+		if err != nil {
+			return s, err
+		}
+	}
+
+	return s, nil
+}
+
+// Calls item for each element of a parenthesized list
+func (p *definitionParser) list(item func() error) error {
+	if err := p.expectSymbol("("); err != nil {
+		return err
+	}
+	for !p.acceptSymbol(")") {
+		if err := item(); err != nil {
+			return err
+		}
+		if !p.acceptSymbol(",") && !p.peekSymbol(")") {
+			return p.errorf("expected , or )")
+		}
+	}
+	return nil
+}
+
+func (p *definitionParser) alias() (string, error) {
+	if !p.accept("as") {
+		return "", nil
+	}
+	n, err := p.name()
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(n, "."), nil
+}
+
+func (p *definitionParser) include(s *SourceDefinition) error {
+	for {
+		switch {
+		case p.accept("header"):
+			var h HeaderStruct
+			var err error
+			if h.Header, err = p.str(); err != nil {
+				return err
+			}
+			if h.Alias, err = p.alias(); err != nil {
+				return err
+			}
+			h.Bytes = p.accept("bytes")
+			s.IncludeHeader = append(s.IncludeHeader, h)
+
+		case p.accept("headers"):
+			s.IncludeHeaders.All = true
+			if p.peekSymbol("(") {
+				s.IncludeHeaders.All = false
+				err := p.list(func() error {
+					not := p.accept("not")
+					h, err := p.str()
+					if not {
+						s.IncludeHeaders.Not = append(s.IncludeHeaders.Not, h)
+					} else {
+						s.IncludeHeaders.Only = append(s.IncludeHeaders.Only, h)
+					}
+					return err
+				})
+				if err != nil {
+					return err
+				}
+			}
+			a, err := p.alias()
+			if err != nil {
+				return err
+			}
+			s.Include["headers"] = a
+
+		default:
+			if p.done() || p.t[p.i].kind != tokenWord {
+				return p.errorf("expected an include item")
+			}
+			item := p.t[p.i].text
+			p.i++
+			a, err := p.alias()
+			if err != nil {
+				return err
+			}
+			s.Include[item] = a
+		}
+
+		if !p.acceptSymbol(",") {
+			return nil
+		}
+	}
+}
+
+// Parses the CHECK clause of a webhook source
+func (p *definitionParser) check(s *SourceDefinition) error {
+	if err := p.expectSymbol("("); err != nil {
+		return err
+	}
+
+	if p.accept("with") {
+		err := p.list(func() error {
+			var c CheckOptionsStruct
+			switch {
+			case p.accept("headers"):
+				c.Field.Headers = true
+			case p.accept("body"):
+				c.Field.Body = true
+			case p.accept("secret"):
+				o, err := p.object()
+				if err != nil {
+					return err
+				}
+				c.Field.Secret = o
+			default:
+				return p.errorf("unsupported check option")
+			}
+
+			var err error
+			if c.Alias, err = p.alias(); err != nil {
+				return err
+			}
+			c.Bytes = p.accept("bytes")
+			s.CheckOptions = append(s.CheckOptions, c)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	s.CheckExpression = p.expression()
+	return p.expectSymbol(")")
+}
+
+// Parses the schema registry connection of a format
+func (p *definitionParser) schemaRegistry() (IdentifierSchemaStruct, error) {
+	if err := p.expect("using confluent schema registry connection"); err != nil {
+		return IdentifierSchemaStruct{}, err
+	}
+	return p.object()
+}
+
+func (p *definitionParser) sourceFormat() (SourceFormatSpecStruct, error) {
+	var f SourceFormatSpecStruct
+	var err error
+
+	switch {
+	case p.accept("avro"):
+		a := &AvroFormatSpec{}
+		if a.SchemaRegistryConnection, err = p.schemaRegistry(); err != nil {
+			return f, err
+		}
+		f.Avro = a
+
+		for {
+			switch {
+			case p.peekSymbol("("):
+				err = p.skipParenthesized()
+			case p.accept("seed"):
+				err = p.seed()
+			case p.accept("key strategy"):
+				a.KeyStrategy, err = p.strategy()
+			case p.accept("value strategy"):
+				a.ValueStrategy, err = p.strategy()
+			default:
+				return f, nil
+			}
+			if err != nil {
+				return f, err
+			}
+		}
+
+	case p.accept("protobuf"):
+		pb := &ProtobufFormatSpec{}
+		if p.accept("message") {
+			if pb.MessageName, err = p.str(); err != nil {
+				return f, err
+			}
+		}
+		if pb.SchemaRegistryConnection, err = p.schemaRegistry(); err != nil {
+			return f, err
+		}
+		f.Protobuf = pb
+
+		if p.accept("seed") {
+			p.accept("key schema")
+			for !p.done() && !p.peek("value schema") && !p.peek("key format") && !p.peek("value format") {
+				p.i++
+			}
+			if p.accept("value schema") {
+				if _, err := p.str(); err != nil {
+					return f, err
+				}
+				if p.accept("message") {
+					m, err := p.str()
+					if err != nil {
+						return f, err
+					}
+					// Seeded messages are fully qualified
+					pb.MessageName = strings.TrimPrefix(m, ".")
+				}
+			}
+		}
+
+	case p.accept("csv with"):
+		c := &CsvFormatSpec{}
+		if p.accept("header") {
+			c.Header = []string{}
+			if p.peekSymbol("(") {
+				err = p.list(func() error {
+					n, err := p.name()
+					c.Header = append(c.Header, strings.Join(n, "."))
+					return err
+				})
+			}
+		} else {
+			if p.done() {
+				return f, p.errorf("expected a number of columns")
+			}
+			if c.Columns, err = strconv.Atoi(p.t[p.i].text); err != nil {
+				return f, p.errorf("expected a number of columns")
+			}
+			p.i++
+			err = p.expect("columns")
+		}
+		if err != nil {
+			return f, err
+		}
+		if p.accept("delimited by") {
+			if c.DelimitedBy, err = p.str(); err != nil {
+				return f, err
+			}
+		}
+		f.Csv = c
+
+	case p.accept("json"):
+		f.Json = true
+
+	case p.accept("text"):
+		f.Text = true
+
+	case p.accept("bytes"):
+		f.Bytes = true
+
+	default:
+		return f, p.errorf("unsupported format")
+	}
+
+	return f, nil
+}
+
+// Skips the schemas that seed an Avro format
+func (p *definitionParser) seed() error {
+	if p.accept("key schema") {
+		if _, err := p.str(); err != nil {
+			return err
+		}
+	}
+	if err := p.expect("value schema"); err != nil {
+		return err
+	}
+	_, err := p.str()
+	return err
+}
+
+func (p *definitionParser) strategy() (string, error) {
+	switch {
+	case p.accept("latest"):
+		return "LATEST", nil
+	case p.accept("inline"):
+		_, err := p.str()
+		return "INLINE", err
+	case p.accept("id"):
+		_, err := p.value()
+		return "ID", err
+	}
+	return "", p.errorf("unsupported strategy")
+}
+
+func ParseSinkDefinition(createSql string) (SinkDefinition, error) {
+	p := newDefinitionParser(createSql)
+	s := SinkDefinition{Options: Options{}, With: Options{}}
+
+	if err := p.header("sink"); err != nil {
+		return s, err
+	}
+
+	var err error
+	if err := p.expect("from"); err != nil {
+		return s, err
+	}
+	if s.From, err = p.object(); err != nil {
+		return s, err
+	}
+	if err := p.expect("into kafka connection"); err != nil {
+		return s, err
+	}
+	if s.Connection, err = p.object(); err != nil {
+		return s, err
+	}
+
+	if p.peekSymbol("(") {
+		err = p.eachOption(func(name string) error {
+			p.acceptSymbol("=")
+			if name != "topic config" {
+				v, err := p.value()
+				s.Options[name] = v
+				return err
+			}
+
+			if err := p.expect("map"); err != nil {
+				return err
+			}
+			if err := p.expectSymbol("["); err != nil {
+				return err
+			}
+			s.TopicConfig = map[string]string{}
+			for !p.acceptSymbol("]") {
+				k, err := p.str()
+				if err != nil {
+					return err
+				}
+				if err := p.expectSymbol("=>"); err != nil {
+					return err
+				}
+				v, err := p.str()
+				if err != nil {
+					return err
+				}
+				s.TopicConfig[k] = v
+				if !p.acceptSymbol(",") && !p.peekSymbol("]") {
+					return p.errorf("expected , or ]")
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return s, err
+		}
+	}
+
+	for !p.done() {
+		switch {
+		case p.peek("key format"):
+			p.accept("key format")
+			s.KeyFormat, err = p.sinkFormat()
+
+		case p.accept("key"):
+			err = p.list(func() error {
+				n, err := p.name()
+				s.Key = append(s.Key, strings.Join(n, "."))
+				return err
+			})
+			s.KeyNotEnforced = p.accept("not enforced")
+
+		case p.accept("headers"):
+			var n []string
+			n, err = p.name()
+			s.Headers = strings.Join(n, ".")
+
+		case p.accept("value format"):
+			s.ValueFormat, err = p.sinkFormat()
+
+		case p.accept("format"):
+			s.Format, err = p.sinkFormat()
+
+		case p.accept("envelope"):
+			switch {
+			case p.accept("debezium"):
+				s.Envelope.Debezium = true
+			case p.accept("upsert"):
+				s.Envelope.Upsert = true
+			default:
+				return s, p.errorf("unsupported envelope")
+			}
+
+		case p.peek("with"):
+			s.With, err = p.with()
+
+		default:
+			return s, p.errorf("unsupported clause")
+		}
+
+		if err != nil {
+			return s, err
+		}
+	}
+
+	return s, nil
+}
+
+func (p *definitionParser) sinkFormat() (SinkFormatSpecStruct, error) {
+	var f SinkFormatSpecStruct
+	var err error
+
+	switch {
+	case p.accept("avro"):
+		a := &SinkAvroFormatSpec{}
+		if a.SchemaRegistryConnection, err = p.schemaRegistry(); err != nil {
+			return f, err
+		}
+		f.Avro = a
+		if p.peekSymbol("(") {
+			err = p.list(func() error { return p.sinkAvroOption(a) })
+		}
+
+	case p.accept("json"):
+		f.Json = true
+
+	case p.accept("text"):
+		f.Text = true
+
+	case p.accept("bytes"):
+		f.Bytes = true
+
+	default:
+		return f, p.errorf("unsupported format")
+	}
+
+	return f, err
+}
+
+func (p *definitionParser) sinkAvroOption(a *SinkAvroFormatSpec) error {
+	var err error
+	switch {
+	case p.accept("avro key fullname"):
+		p.acceptSymbol("=")
+		a.AvroKeyFullname, err = p.str()
+		return err
+
+	case p.accept("avro value fullname"):
+		p.acceptSymbol("=")
+		a.AvroValueFullname, err = p.str()
+		return err
+	}
+
+	key := p.accept("key")
+	value := p.accept("value")
+
+	switch {
+	case p.accept("doc on type"):
+		d := AvroDocType{Key: key, Value: value}
+		if d.Object, err = p.object(); err != nil {
+			return err
+		}
+		p.acceptSymbol("=")
+		d.Doc, err = p.str()
+		a.DocType = append(a.DocType, d)
+		return err
+
+	case p.accept("doc on column"):
+		d := AvroDocColumn{Key: key, Value: value}
+		if p.peekSymbol("[") {
+			if d.Object, err = p.object(); err != nil {
+				return err
+			}
+			if err := p.expectSymbol("."); err != nil {
+				return err
+			}
+			n, err := p.name()
+			if err != nil {
+				return err
+			}
+			d.Column = n[len(n)-1]
+		} else {
+			n, err := p.name()
+			if err != nil {
+				return err
+			}
+			if len(n) < 2 {
+				return p.errorf("expected a column of an object")
+			}
+			d.Object = identifierFromParts(n[:len(n)-1])
+			d.Column = n[len(n)-1]
+		}
+		p.acceptSymbol("=")
+		d.Doc, err = p.str()
+		a.DocColumn = append(a.DocColumn, d)
+		return err
+
+	case key || value:
+		return p.errorf("unsupported option")
+	}
+
+	// Options the provider does not manage
+	p.optionName()
+	p.acceptSymbol("=")
+	_, err = p.value()
+	return err
+}
+
+func ParseSubsourceDefinition(createSql string) (SubsourceDefinition, error) {
+	p := newDefinitionParser(createSql)
+	s := SubsourceDefinition{Options: Options{}}
+
+	if err := p.header("subsource"); err != nil {
+		return s, err
+	}
+	// Columns and constraints of the subsource
+	if p.peekSymbol("(") {
+		if err := p.skipParenthesized(); err != nil {
+			return s, err
+		}
+	}
+
+	var err error
+	if p.accept("of source") {
+		if s.Source, err = p.object(); err != nil {
+			return s, err
+		}
+	}
+
+	if p.accept("with") {
+		err = p.eachOption(func(name string) error {
+			p.acceptSymbol("=")
+			if name == "external reference" {
+				s.ExternalReference, err = p.name()
+				return err
+			}
+			v, err := p.value()
+			s.Options[name] = v
+			return err
+		})
+		if err != nil {
+			return s, err
+		}
+	}
+
+	if !p.done() {
+		return s, p.errorf("unexpected clause")
+	}
+	return s, nil
+}
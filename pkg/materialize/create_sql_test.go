@@ -0,0 +1,158 @@
+package materialize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConnectionDefinition(t *testing.T) {
+	r := require.New(t)
+
+	c, err := ParseConnectionDefinition(`CREATE CONNECTION "materialize"."public"."kafka" TO KAFKA (BROKERS = ('b-1:9092' USING AWS PRIVATELINK [u1 AS "materialize"."public"."privatelink"] (PORT = 9001, AVAILABILITY ZONE = 'use1-az1'), 'b-2:9092'), SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'user', SASL PASSWORD = SECRET [u2 AS "materialize"."public"."password"], SECURITY PROTOCOL = SASL_SSL, PROGRESS TOPIC = 'progress') WITH (VALIDATE = false);`)
+	r.NoError(err)
+	r.Equal("kafka", c.Type)
+	r.Equal([]KafkaBroker{
+		{Broker: "b-1:9092", TargetGroupPort: 9001, AvailabilityZone: "use1-az1", PrivateLinkConnection: IdentifierSchemaStruct{Name: "privatelink", SchemaName: "public", DatabaseName: "materialize"}},
+		{Broker: "b-2:9092"},
+	}, c.Brokers)
+	r.Equal("PLAIN", c.Options["sasl mechanisms"].Text)
+	r.Equal("user", c.Options["sasl username"].Text)
+	r.Equal(OptionValue{Object: IdentifierSchemaStruct{Name: "password", SchemaName: "public", DatabaseName: "materialize"}, Secret: true}, c.Options["sasl password"])
+	r.Equal("sasl_ssl", c.Options["security protocol"].Text)
+	r.Equal("false", c.With["validate"].Text)
+
+	c, err = ParseConnectionDefinition(`CREATE CONNECTION "materialize"."public"."kafka" TO KAFKA (BROKER = 'b:9092' USING SSH TUNNEL [u3 AS "materialize"."public"."ssh"])`)
+	r.NoError(err)
+	r.Equal([]KafkaBroker{{Broker: "b:9092"}}, c.Brokers)
+	r.Equal("ssh", c.Options["ssh tunnel"].Object.Name)
+
+	c, err = ParseConnectionDefinition(`CREATE CONNECTION "materialize"."public"."pl" TO AWS PRIVATELINK (SERVICE NAME = 'com.amazonaws.vpce.us-east-1.vpce-svc-1', AVAILABILITY ZONES = ('use1-az1', 'use1-az2'))`)
+	r.NoError(err)
+	r.Equal("aws privatelink", c.Type)
+	r.Equal([]OptionValue{{Text: "use1-az1"}, {Text: "use1-az2"}}, c.Options["availability zones"].Values)
+
+	c, err = ParseConnectionDefinition(`CREATE CONNECTION "materialize"."public"."pg" TO POSTGRES (HOST = 'postgres', PORT = 5432, USER = 'materialize', PASSWORD = SECRET "materialize"."public"."pw", DATABASE = 'postgres')`)
+	r.NoError(err)
+	r.Equal("5432", c.Options["port"].Text)
+	r.Equal("pw", c.Options["password"].Object.Name)
+
+	_, err = ParseConnectionDefinition(`CREATE CONNECTION "materialize"."public"."kafka" TO KAFKA (BROKER = 'b:9092') EXTRA`)
+	r.Error(err)
+}
+
+func TestParseSourceDefinitionKafka(t *testing.T) {
+	r := require.New(t)
+
+	s, err := ParseSourceDefinition(`CREATE SOURCE "materialize"."public"."s" IN CLUSTER [u1] FROM KAFKA CONNECTION [u2 AS "materialize"."public"."kafka"] (START OFFSET = (0, 1), START TIMESTAMP = -1000, TOPIC = 'events') KEY FORMAT TEXT VALUE FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION [u3 AS "materialize"."public"."csr"] SEED VALUE SCHEMA '{"type": "long"}' VALUE STRATEGY LATEST INCLUDE KEY AS "k", PARTITION, HEADERS AS "h" ENVELOPE UPSERT EXPOSE PROGRESS AS [u4 AS "materialize"."public"."s_progress"]`)
+	r.NoError(err)
+	r.Equal("kafka", s.Type)
+	r.Equal("kafka", s.Connection.Name)
+	r.Equal("events", s.Options["topic"].Text)
+	r.Equal("-1000", s.Options["start timestamp"].Text)
+	r.Equal([]OptionValue{{Text: "0"}, {Text: "1"}}, s.Options["start offset"].Values)
+	r.True(s.KeyFormat.Text)
+	r.Equal(&AvroFormatSpec{SchemaRegistryConnection: IdentifierSchemaStruct{Name: "csr", SchemaName: "public", DatabaseName: "materialize"}, ValueStrategy: "LATEST"}, s.ValueFormat.Avro)
+	r.Equal(map[string]string{"key": "k", "partition": "", "headers": "h"}, s.Include)
+	r.True(s.Envelope.Upsert)
+	r.Equal("s_progress", s.ExposeProgress.Name)
+
+	s, err = ParseSourceDefinition(`CREATE SOURCE "materialize"."public"."s" FROM KAFKA CONNECTION "materialize"."public"."kafka" (TOPIC = 'events') FORMAT CSV WITH 2 COLUMNS DELIMITED BY ';' ENVELOPE NONE`)
+	r.NoError(err)
+	r.Equal(&CsvFormatSpec{Columns: 2, DelimitedBy: ";"}, s.Format.Csv)
+	r.True(s.Envelope.None)
+
+	_, err = ParseSourceDefinition(`CREATE SOURCE "materialize"."public"."s" FROM KAFKA CONNECTION "materialize"."public"."kafka" (TOPIC = 'events') FORMAT REGEX '.*'`)
+	r.Error(err)
+}
+
+func TestParseSourceDefinitionLoadGenerator(t *testing.T) {
+	r := require.New(t)
+
+	s, err := ParseSourceDefinition(`CREATE SOURCE "materialize"."public"."s" IN CLUSTER [u1] FROM LOAD GENERATOR COUNTER (TICK INTERVAL = '1s', SCALE FACTOR = 0.5) EXPOSE PROGRESS AS [u2 AS "materialize"."public"."s_progress"]`)
+	r.NoError(err)
+	r.Equal("load generator", s.Type)
+	r.Equal("counter", s.LoadGenerator)
+	r.Equal("1s", s.Options["tick interval"].Text)
+	r.Equal("0.5", s.Options["scale factor"].Text)
+
+	s, err = ParseSourceDefinition(`CREATE SOURCE "materialize"."public"."s" FROM LOAD GENERATOR AUCTION FOR ALL TABLES`)
+	r.NoError(err)
+	r.Equal("auction", s.LoadGenerator)
+	r.Empty(s.Options)
+}
+
+func TestParseSourceDefinitionWebhook(t *testing.T) {
+	r := require.New(t)
+
+	s, err := ParseSourceDefinition(`CREATE SOURCE "materialize"."public"."s" IN CLUSTER [u1] FROM WEBHOOK BODY FORMAT JSON ARRAY INCLUDE HEADER 'timestamp' AS "ts" BYTES INCLUDE HEADERS (NOT 'authorization') CHECK (WITH (HEADERS, BODY AS "request_body" BYTES, SECRET [u2 AS "materialize"."public"."key"]) constant_time_eq(headers->'x-signature', hmac(request_body, "key", 'sha256')))`)
+	r.NoError(err)
+	r.Equal("webhook", s.Type)
+	r.Equal("JSON ARRAY", s.BodyFormat)
+	r.Equal([]HeaderStruct{{Header: "timestamp", Alias: "ts", Bytes: true}}, s.IncludeHeader)
+	r.Equal(IncludeHeadersStruct{Not: []string{"authorization"}}, s.IncludeHeaders)
+	r.Equal([]CheckOptionsStruct{
+		{Field: FieldStruct{Headers: true}},
+		{Field: FieldStruct{Body: true}, Alias: "request_body", Bytes: true},
+		{Field: FieldStruct{Secret: IdentifierSchemaStruct{Name: "key", SchemaName: "public", DatabaseName: "materialize"}}},
+	}, s.CheckOptions)
+	r.Equal(`constant_time_eq(headers -> 'x-signature', hmac(request_body, key, 'sha256'))`, s.CheckExpression)
+}
+
+func TestParseSourceDefinitionPostgres(t *testing.T) {
+	r := require.New(t)
+
+	s, err := ParseSourceDefinition(`CREATE SOURCE "materialize"."public"."s" IN CLUSTER [u1] FROM POSTGRES CONNECTION [u2 AS "materialize"."public"."pg"] (PUBLICATION = 'mz_source', DETAILS = 'abc', TEXT COLUMNS = ("public"."t"."a")) FOR TABLES ("public"."t" AS "materialize"."public"."t") EXPOSE PROGRESS AS [u3 AS "materialize"."public"."s_progress"]`)
+	r.NoError(err)
+	r.Equal("postgres", s.Type)
+	r.Equal("mz_source", s.Options["publication"].Text)
+	r.Equal([]OptionValue{{Text: "public.t.a"}}, s.Options["text columns"].Values)
+	r.Equal([]TableStruct{{Name: "public.t", Alias: "materialize.public.t"}}, s.Tables)
+}
+
+func TestParseSubsourceDefinition(t *testing.T) {
+	r := require.New(t)
+
+	s, err := ParseSubsourceDefinition(`CREATE SUBSOURCE "materialize"."public"."t" ("a" int4 NOT NULL, CONSTRAINT "pk" PRIMARY KEY ("a")) OF SOURCE [u1 AS "materialize"."public"."s"] WITH (EXTERNAL REFERENCE = "postgres"."public"."t", TEXT COLUMNS = ("a"), DETAILS = 'abc')`)
+	r.NoError(err)
+	r.Equal("s", s.Source.Name)
+	r.Equal([]string{"postgres", "public", "t"}, s.ExternalReference)
+
+	s, err = ParseSubsourceDefinition(`CREATE SUBSOURCE "materialize"."public"."s_progress" ("lsn" uint8) WITH (PROGRESS = true)`)
+	r.NoError(err)
+	r.Empty(s.ExternalReference)
+}
+
+func TestParseSinkDefinition(t *testing.T) {
+	r := require.New(t)
+
+	s, err := ParseSinkDefinition(`CREATE SINK "materialize"."public"."k" IN CLUSTER [u1] FROM [u2 AS "materialize"."public"."t"] INTO KAFKA CONNECTION [u3 AS "materialize"."public"."kafka"] (TOPIC = 'topic', COMPRESSION TYPE = gzip, PARTITION BY = seahash("a"::text), TOPIC PARTITION COUNT = 4, TOPIC CONFIG = MAP['cleanup.policy' => 'compact']) KEY ("a", "b") NOT ENFORCED HEADERS "h" FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION [u4 AS "materialize"."public"."csr"] (AVRO KEY FULLNAME = 'k', AVRO VALUE FULLNAME = 'v', KEY DOC ON TYPE [u2 AS "materialize"."public"."t"] = 'table', DOC ON COLUMN [u2 AS "materialize"."public"."t"]."a" = 'column', NULL DEFAULTS = true) ENVELOPE UPSERT WITH (SNAPSHOT = false)`)
+	r.NoError(err)
+	r.Equal("t", s.From.Name)
+	r.Equal("kafka", s.Connection.Name)
+	r.Equal("topic", s.Options["topic"].Text)
+	r.Equal("gzip", s.Options["compression type"].Text)
+	r.Equal(`seahash(a::text)`, s.Options["partition by"].Text)
+	r.Equal("4", s.Options["topic partition count"].Text)
+	r.Equal(map[string]string{"cleanup.policy": "compact"}, s.TopicConfig)
+	r.Equal([]string{"a", "b"}, s.Key)
+	r.True(s.KeyNotEnforced)
+	r.Equal("h", s.Headers)
+
+	t1 := IdentifierSchemaStruct{Name: "t", SchemaName: "public", DatabaseName: "materialize"}
+	r.Equal(&SinkAvroFormatSpec{
+		SchemaRegistryConnection: IdentifierSchemaStruct{Name: "csr", SchemaName: "public", DatabaseName: "materialize"},
+		AvroKeyFullname:          "k",
+		AvroValueFullname:        "v",
+		DocType:                  []AvroDocType{{Object: t1, Doc: "table", Key: true}},
+		DocColumn:                []AvroDocColumn{{Object: t1, Column: "a", Doc: "column"}},
+	}, s.Format.Avro)
+	r.True(s.Envelope.Upsert)
+	r.Equal("false", s.With["snapshot"].Text)
+
+	s, err = ParseSinkDefinition(`CREATE SINK "materialize"."public"."k" FROM "materialize"."public"."t" INTO KAFKA CONNECTION "materialize"."public"."kafka" (TOPIC = 'topic') KEY FORMAT TEXT VALUE FORMAT JSON ENVELOPE DEBEZIUM`)
+	r.NoError(err)
+	r.True(s.KeyFormat.Text)
+	r.True(s.ValueFormat.Json)
+	r.True(s.Envelope.Debezium)
+}
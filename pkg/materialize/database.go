@@ -59,10 +59,10 @@ var databaseQuery = NewBaseQuery(`
 		ON mz_databases.id = comments.id`)
 
 func DatabaseId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
-	q := databaseQuery.QueryPredicate(map[string]string{"mz_databases.name": obj.Name})
+	q, args := databaseQuery.QueryPredicate(map[string]string{"mz_databases.name": obj.Name})
 
 	var c DatabaseParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -70,10 +70,10 @@ func DatabaseId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 }
 
 func ScanDatabase(conn *sqlx.DB, id string) (DatabaseParams, error) {
-	q := databaseQuery.QueryPredicate(map[string]string{"mz_databases.id": id})
+	q, args := databaseQuery.QueryPredicate(map[string]string{"mz_databases.id": id})
 
 	var c DatabaseParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -81,12 +81,36 @@ func ScanDatabase(conn *sqlx.DB, id string) (DatabaseParams, error) {
 }
 
 func ListDatabases(conn *sqlx.DB) ([]DatabaseParams, error) {
-	q := databaseQuery.QueryPredicate(map[string]string{})
+	q, args := databaseQuery.QueryPredicate(map[string]string{})
 
 	var c []DatabaseParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
+
+// The database of the session
+func CurrentDatabase(conn *sqlx.DB) (string, error) {
+	var name string
+	if err := getWithRetry(conn, &name, `SHOW DATABASE;`); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// The database and the schemas of the search path of the session, including
+// the implicit system schemas
+func CurrentSearchPath(conn *sqlx.DB) (SearchPath, error) {
+	databaseName, err := CurrentDatabase(conn)
+	if err != nil {
+		return SearchPath{}, err
+	}
+
+	var schemaNames []string
+	if err := selectWithRetry(conn, &schemaNames, `SELECT unnest(current_schemas(true));`); err != nil {
+		return SearchPath{}, err
+	}
+	return SearchPath{DatabaseName: databaseName, SchemaNames: schemaNames}, nil
+}
@@ -40,10 +40,41 @@ func ListDependencies(conn *sqlx.DB, objectId, objectType string) ([]DependencyP
 		p["mz_objects.type"] = objectType
 	}
 
-	q := dependencyQuery.QueryPredicate(p)
+	q, args := dependencyQuery.QueryPredicate(p)
 
 	var d []DependencyParams
-	if err := conn.Select(&d, q); err != nil {
+	if err := selectWithRetry(conn, &d, q, args...); err != nil {
+		return d, err
+	}
+
+	return d, nil
+}
+
+var dependentQuery = NewBaseQuery(`
+	SELECT
+		mz_object_dependencies.object_id,
+		mz_object_dependencies.referenced_object_id,
+		mz_objects.name AS object_name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_objects.type
+	FROM mz_internal.mz_object_dependencies
+	JOIN mz_objects
+		ON mz_object_dependencies.object_id = mz_objects.id
+	JOIN mz_schemas
+		ON mz_objects.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id`)
+
+// Objects that reference the given object and would block it from being dropped or replaced
+func ListDependents(conn *sqlx.DB, objectId string) ([]DependencyParams, error) {
+	p := map[string]string{
+		"mz_object_dependencies.referenced_object_id": objectId,
+	}
+	q, args := dependentQuery.QueryPredicate(p)
+
+	var d []DependencyParams
+	if err := selectWithRetry(conn, &d, q, args...); err != nil {
 		return d, err
 	}
 
@@ -0,0 +1,25 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestListDependents(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_object_dependencies.referenced_object_id = 'u1'`
+		testhelpers.MockDependentScan(mock, p)
+
+		d, err := ListDependents(db, "u1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(d) != 1 || d[0].ObjectName.String != "index" || d[0].Type.String != "index" {
+			t.Fatalf("unexpected dependents: %v", d)
+		}
+	})
+}
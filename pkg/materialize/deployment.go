@@ -0,0 +1,90 @@
+package materialize
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// A deployment pairs a production schema and cluster with shadow copies that
+// are promoted by swapping their names in a single transaction
+type DeploymentBuilder struct {
+	conn              *sqlx.DB
+	databaseName      string
+	schemaName        string
+	clusterName       string
+	shadowSchemaName  string
+	shadowClusterName string
+}
+
+func NewDeploymentBuilder(conn *sqlx.DB, obj MaterializeObject) *DeploymentBuilder {
+	return &DeploymentBuilder{
+		conn:         conn,
+		databaseName: obj.DatabaseName,
+		schemaName:   obj.Name,
+	}
+}
+
+func (b *DeploymentBuilder) ClusterName(c string) *DeploymentBuilder {
+	b.clusterName = c
+	return b
+}
+
+func (b *DeploymentBuilder) ShadowSchemaName(s string) *DeploymentBuilder {
+	b.shadowSchemaName = s
+	return b
+}
+
+func (b *DeploymentBuilder) ShadowClusterName(c string) *DeploymentBuilder {
+	b.shadowClusterName = c
+	return b
+}
+
+func (b *DeploymentBuilder) QualifiedShadowSchemaName() string {
+	return QualifiedName(b.databaseName, b.shadowSchemaName)
+}
+
+func (b *DeploymentBuilder) QualifiedShadowClusterName() string {
+	return QualifiedName(b.shadowClusterName)
+}
+
+func (b *DeploymentBuilder) transaction(statements []string) error {
+	tx, err := b.conn.Beginx()
+	if err != nil {
+		return err
+	}
+
+	for _, s := range statements {
+		if _, err := tx.Exec(s); err != nil {
+			log.Printf("[DEBUG] error executing: %s", s)
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Promote swaps the shadow schema and cluster with the production schema and
+// cluster. Both swaps are committed together so readers never observe a
+// partially promoted deployment
+func (b *DeploymentBuilder) Promote() error {
+	s := []string{
+		fmt.Sprintf(`ALTER SCHEMA %s SWAP WITH %s;`, QualifiedName(b.databaseName, b.schemaName), QuoteIdentifier(b.shadowSchemaName)),
+		fmt.Sprintf(`ALTER CLUSTER %s SWAP WITH %s;`, QualifiedName(b.clusterName), QuoteIdentifier(b.shadowClusterName)),
+	}
+	return b.transaction(s)
+}
+
+// DropShadow removes the shadow schema and cluster along with every object
+// they contain
+func (b *DeploymentBuilder) DropShadow() error {
+	s := Builder{b.conn, Schema}
+	if err := s.exec(fmt.Sprintf(`DROP SCHEMA IF EXISTS %s CASCADE;`, b.QualifiedShadowSchemaName())); err != nil {
+		return err
+	}
+
+	c := Builder{b.conn, Cluster}
+	return c.exec(fmt.Sprintf(`DROP CLUSTER IF EXISTS %s CASCADE;`, b.QualifiedShadowClusterName()))
+}
@@ -0,0 +1,62 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+var deployment = MaterializeObject{Name: "schema", DatabaseName: "database"}
+
+func TestDeploymentPromote(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`ALTER SCHEMA "database"."schema" SWAP WITH "schema_deploy";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SWAP WITH "cluster_deploy";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		b := NewDeploymentBuilder(db, deployment)
+		b.ClusterName("cluster")
+		b.ShadowSchemaName("schema_deploy")
+		b.ShadowClusterName("cluster_deploy")
+
+		if err := b.Promote(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestDeploymentPromoteRollback(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`ALTER SCHEMA "database"."schema" SWAP WITH "schema_deploy";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SWAP WITH "cluster_deploy";`).WillReturnError(sqlmock.ErrCancelled)
+		mock.ExpectRollback()
+
+		b := NewDeploymentBuilder(db, deployment)
+		b.ClusterName("cluster")
+		b.ShadowSchemaName("schema_deploy")
+		b.ShadowClusterName("cluster_deploy")
+
+		if err := b.Promote(); err == nil {
+			t.Fatal("expected promote to fail")
+		}
+	})
+}
+
+func TestDeploymentDropShadow(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`DROP SCHEMA IF EXISTS "database"."schema_deploy" CASCADE;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`DROP CLUSTER IF EXISTS "cluster_deploy" CASCADE;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewDeploymentBuilder(db, deployment)
+		b.ShadowSchemaName("schema_deploy")
+		b.ShadowClusterName("cluster_deploy")
+
+		if err := b.DropShadow(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
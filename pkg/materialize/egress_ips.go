@@ -1,5 +1,14 @@
 package materialize
 
-func ReadEgressIpsDatasource() string {
-	return "SELECT egress_ip FROM materialize.mz_catalog.mz_egress_ips;"
+import "github.com/jmoiron/sqlx"
+
+func ListEgressIps(conn *sqlx.DB) ([]string, error) {
+	q := `SELECT egress_ip FROM materialize.mz_catalog.mz_egress_ips;`
+
+	var c []string
+	if err := selectWithRetry(conn, &c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
 }
@@ -1,5 +1,10 @@
 package materialize
 
+import (
+	"fmt"
+	"strings"
+)
+
 type AvroFormatSpec struct {
 	SchemaRegistryConnection IdentifierSchemaStruct
 	KeyStrategy              string
@@ -26,15 +31,129 @@ type SourceFormatSpecStruct struct {
 	Json     bool
 }
 
+func (f SourceFormatSpecStruct) empty() bool {
+	return f.Avro == nil && f.Protobuf == nil && f.Csv == nil && !f.Bytes && !f.Text && !f.Json
+}
+
+// Returns the format specifier of a source format without the FORMAT keyword
+// so it can be used for FORMAT, KEY FORMAT and VALUE FORMAT
+func (f SourceFormatSpecStruct) sourceFormat() string {
+	switch {
+	case f.Avro != nil:
+		q := strings.Builder{}
+		q.WriteString(fmt.Sprintf(`AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, f.Avro.SchemaRegistryConnection.QualifiedName()))
+		if f.Avro.KeyStrategy != "" {
+			q.WriteString(fmt.Sprintf(` KEY STRATEGY %s`, f.Avro.KeyStrategy))
+		}
+		if f.Avro.ValueStrategy != "" {
+			q.WriteString(fmt.Sprintf(` VALUE STRATEGY %s`, f.Avro.ValueStrategy))
+		}
+		return q.String()
+	case f.Protobuf != nil:
+		if f.Protobuf.MessageName != "" {
+			return fmt.Sprintf(`PROTOBUF MESSAGE %s USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QuoteString(f.Protobuf.MessageName), f.Protobuf.SchemaRegistryConnection.QualifiedName())
+		}
+		return fmt.Sprintf(`PROTOBUF USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, f.Protobuf.SchemaRegistryConnection.QualifiedName())
+	case f.Csv != nil:
+		q := strings.Builder{}
+		if len(f.Csv.Header) > 0 {
+			q.WriteString(fmt.Sprintf(`CSV WITH HEADER (%s)`, strings.Join(f.Csv.Header, ", ")))
+		} else {
+			q.WriteString(fmt.Sprintf(`CSV WITH %d COLUMNS`, f.Csv.Columns))
+		}
+		if f.Csv.DelimitedBy != "" {
+			q.WriteString(fmt.Sprintf(` DELIMITER %s`, QuoteString(f.Csv.DelimitedBy)))
+		}
+		return q.String()
+	case f.Json:
+		return `JSON`
+	case f.Text:
+		return `TEXT`
+	case f.Bytes:
+		return `BYTES`
+	}
+	return ""
+}
+
+type AvroDocType struct {
+	Object IdentifierSchemaStruct
+	Doc    string
+	Key    bool
+	Value  bool
+}
+
+type AvroDocColumn struct {
+	Object IdentifierSchemaStruct
+	Column string
+	Doc    string
+	Key    bool
+	Value  bool
+}
+
 type SinkAvroFormatSpec struct {
 	SchemaRegistryConnection IdentifierSchemaStruct
 	AvroKeyFullname          string
 	AvroValueFullname        string
+	DocType                  []AvroDocType
+	DocColumn                []AvroDocColumn
 }
 
 type SinkFormatSpecStruct struct {
-	Avro *SinkAvroFormatSpec
-	Json bool
+	Avro  *SinkAvroFormatSpec
+	Json  bool
+	Text  bool
+	Bytes bool
+}
+
+func (f SinkFormatSpecStruct) empty() bool {
+	return f.Avro == nil && !f.Json && !f.Text && !f.Bytes
+}
+
+// Returns the format specifier of a sink format without the FORMAT keyword
+// so it can be used for FORMAT, KEY FORMAT and VALUE FORMAT
+func (f SinkFormatSpecStruct) sinkFormat() string {
+	switch {
+	case f.Avro != nil:
+		q := strings.Builder{}
+		q.WriteString(fmt.Sprintf(`AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, f.Avro.SchemaRegistryConnection.QualifiedName()))
+
+		var o []string
+		if f.Avro.AvroValueFullname != "" && f.Avro.AvroKeyFullname != "" {
+			o = append(o, fmt.Sprintf(`AVRO KEY FULLNAME %s AVRO VALUE FULLNAME %s`, QuoteString(f.Avro.AvroKeyFullname), QuoteString(f.Avro.AvroValueFullname)))
+		}
+
+		for _, t := range f.Avro.DocType {
+			o = append(o, fmt.Sprintf(`%sDOC ON TYPE %s = %s`, avroDocTarget(t.Key, t.Value), t.Object.QualifiedName(), QuoteString(t.Doc)))
+		}
+
+		for _, c := range f.Avro.DocColumn {
+			o = append(o, fmt.Sprintf(`%sDOC ON COLUMN %s.%s = %s`, avroDocTarget(c.Key, c.Value), c.Object.QualifiedName(), QuoteIdentifier(c.Column), QuoteString(c.Doc)))
+		}
+
+		if len(o) > 0 {
+			q.WriteString(fmt.Sprintf(` WITH (%s)`, strings.Join(o, ", ")))
+		}
+		return q.String()
+	case f.Json:
+		return `JSON`
+	case f.Text:
+		return `TEXT`
+	case f.Bytes:
+		return `BYTES`
+	}
+	return ""
+}
+
+// Documentation applies to both the key and value schema unless only one of
+// them is selected
+func avroDocTarget(key, value bool) string {
+	if key && !value {
+		return `KEY `
+	}
+	if value && !key {
+		return `VALUE `
+	}
+	return ""
 }
 
 func GetFormatSpecStruc(v interface{}) SourceFormatSpecStruct {
@@ -116,10 +235,41 @@ func GetSinkFormatSpecStruc(v interface{}) SinkFormatSpecStruct {
 				AvroKeyFullname:          key,
 				AvroValueFullname:        value,
 			}
+
+			if v, ok := avro.([]interface{})[0].(map[string]interface{})["avro_doc_type"]; ok && v != nil {
+				for _, t := range v.([]interface{}) {
+					dt := t.(map[string]interface{})
+					format.Avro.DocType = append(format.Avro.DocType, AvroDocType{
+						Object: GetIdentifierSchemaStruct(databaseName, schemaName, dt["object"]),
+						Doc:    dt["doc"].(string),
+						Key:    dt["key"].(bool),
+						Value:  dt["value"].(bool),
+					})
+				}
+			}
+
+			if v, ok := avro.([]interface{})[0].(map[string]interface{})["avro_doc_column"]; ok && v != nil {
+				for _, c := range v.([]interface{}) {
+					dc := c.(map[string]interface{})
+					format.Avro.DocColumn = append(format.Avro.DocColumn, AvroDocColumn{
+						Object: GetIdentifierSchemaStruct(databaseName, schemaName, dc["object"]),
+						Column: dc["column"].(string),
+						Doc:    dc["doc"].(string),
+						Key:    dc["key"].(bool),
+						Value:  dc["value"].(bool),
+					})
+				}
+			}
 		}
 	}
 	if v, ok := u["json"]; ok {
 		format.Json = v.(bool)
 	}
+	if v, ok := u["text"]; ok {
+		format.Text = v.(bool)
+	}
+	if v, ok := u["bytes"]; ok {
+		format.Bytes = v.(bool)
+	}
 	return format
 }
@@ -1,8 +1,10 @@
 package materialize
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -16,6 +18,7 @@ const (
 	Database         EntityType = "DATABASE"
 	Index            EntityType = "INDEX"
 	MaterializedView EntityType = "MATERIALIZED VIEW"
+	NetworkPolicy    EntityType = "NETWORK POLICY"
 	Privilege        EntityType = "PRIVILEGE"
 	Ownership        EntityType = "OWNERSHIP"
 	Role             EntityType = "ROLE"
@@ -38,8 +41,7 @@ func (b *Builder) exec(statement string) error {
 		statement += ";"
 	}
 
-	_, err := b.conn.Exec(statement)
-	if err != nil {
+	if err := execWithRetry(b.conn, statement); err != nil {
 		log.Printf("[DEBUG] error executing: %s", statement)
 		return err
 	}
@@ -61,3 +63,29 @@ func (b *Builder) resize(name, size string) error {
 	q := fmt.Sprintf(`ALTER %s %s SET (SIZE = '%s');`, b.entity, name, size)
 	return b.exec(q)
 }
+
+func (b *Builder) alterCluster(name, clusterName string) error {
+	q := fmt.Sprintf(`ALTER %s %s SET CLUSTER %s;`, b.entity, name, QuoteIdentifier(clusterName))
+	return b.exec(q)
+}
+
+// Materialize reports statements that are not available for an object type or
+// behind a feature flag without a consistent SQLSTATE
+var notSupportedMessage = regexp.MustCompile(`(?i)(not (yet )?supported|is not enabled|not available|unsupported)`)
+
+// Reports whether the statement failed because Materialize does not support
+// it, as opposed to an error applying it
+func NotSupported(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var s sqlState
+	if errors.As(err, &s) {
+		switch s.SQLState() {
+		case "0A000": // feature_not_supported
+			return true
+		}
+	}
+	return notSupportedMessage.MatchString(err.Error())
+}
@@ -0,0 +1,26 @@
+package materialize
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestNotSupported(t *testing.T) {
+	for _, c := range []struct {
+		err       error
+		supported bool
+	}{
+		{testSQLStateError{"0A000"}, false},
+		{fmt.Errorf("wrapped: %w", testSQLStateError{"0A000"}), false},
+		{testSQLStateError{"42601"}, true},
+		{errors.New("ALTER MATERIALIZED VIEW SET CLUSTER is not supported"), false},
+		{errors.New("db error: ERROR: Expected one of OWNER or RENAME"), true},
+		{testSQLStateError{"42P01"}, true},
+		{nil, true},
+	} {
+		if r := NotSupported(c.err); r == c.supported {
+			t.Errorf("NotSupported(%v) = %t, expected %t", c.err, r, !c.supported)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package materialize
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type HydrationParams struct {
+	ObjectId    sql.NullString `db:"object_id"`
+	ObjectName  sql.NullString `db:"object_name"`
+	ReplicaId   sql.NullString `db:"replica_id"`
+	ReplicaName sql.NullString `db:"replica_name"`
+	ClusterName sql.NullString `db:"cluster_name"`
+	Hydrated    sql.NullBool   `db:"hydrated"`
+}
+
+var hydrationQuery = NewBaseQuery(`
+	SELECT
+		mz_hydration_statuses.object_id,
+		mz_objects.name AS object_name,
+		mz_hydration_statuses.replica_id,
+		mz_cluster_replicas.name AS replica_name,
+		mz_clusters.name AS cluster_name,
+		mz_hydration_statuses.hydrated
+	FROM mz_internal.mz_hydration_statuses
+	JOIN mz_objects
+		ON mz_hydration_statuses.object_id = mz_objects.id
+	JOIN mz_cluster_replicas
+		ON mz_hydration_statuses.replica_id = mz_cluster_replicas.id
+	JOIN mz_clusters
+		ON mz_cluster_replicas.cluster_id = mz_clusters.id`)
+
+func ListHydrationStatuses(conn *sqlx.DB, clusterName string) ([]HydrationParams, error) {
+	p := map[string]string{
+		"mz_clusters.name": clusterName,
+	}
+	q, args := hydrationQuery.QueryPredicate(p)
+
+	var c []HydrationParams
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+func ListObjectHydrationStatuses(conn *sqlx.DB, objectId string) ([]HydrationParams, error) {
+	p := map[string]string{
+		"mz_hydration_statuses.object_id": objectId,
+	}
+	q, args := hydrationQuery.QueryPredicate(p)
+
+	var c []HydrationParams
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// Returns the objects on the cluster that have not finished hydrating on
+// every replica
+func ListUnhydratedObjects(conn *sqlx.DB, clusterName string) ([]HydrationParams, error) {
+	s, err := ListHydrationStatuses(conn, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	var u []HydrationParams
+	for _, h := range s {
+		if !h.Hydrated.Bool {
+			u = append(u, h)
+		}
+	}
+
+	return u, nil
+}
@@ -0,0 +1,41 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestListUnhydratedObjects(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_clusters.name = 'cluster'`
+		testhelpers.MockHydrationScan(mock, p, false)
+
+		u, err := ListUnhydratedObjects(db, "cluster")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(u) != 1 || u[0].ObjectName.String != "materialized_view" {
+			t.Fatalf("unexpected unhydrated objects: %v", u)
+		}
+	})
+}
+
+func TestListUnhydratedObjectsHydrated(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_clusters.name = 'cluster'`
+		testhelpers.MockHydrationScan(mock, p, true)
+
+		u, err := ListUnhydratedObjects(db, "cluster")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(u) != 0 {
+			t.Fatalf("unexpected unhydrated objects: %v", u)
+		}
+	})
+}
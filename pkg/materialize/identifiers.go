@@ -1,5 +1,7 @@
 package materialize
 
+import "fmt"
+
 // Any Materialize Object. Will contain name and database and schema
 // If no database or schema is provided will inherit those values
 type IdentifierSchemaStruct struct {
@@ -30,3 +32,19 @@ func GetIdentifierSchemaStruct(databaseName string, schemaName string, v interfa
 func (i *IdentifierSchemaStruct) QualifiedName() string {
 	return QualifiedName(i.DatabaseName, i.SchemaName, i.Name)
 }
+
+// The object as the value of a connection option. Empty when no object is set
+func (i IdentifierSchemaStruct) OptionValue() string {
+	if i.Name == "" {
+		return ""
+	}
+	return i.QualifiedName()
+}
+
+// The secret as the value of a connection option. Empty when no secret is set
+func (i IdentifierSchemaStruct) SecretOptionValue() string {
+	if i.Name == "" {
+		return ""
+	}
+	return fmt.Sprintf(`SECRET %s`, i.QualifiedName())
+}
@@ -42,6 +42,10 @@ func NewIndexBuilder(conn *sqlx.DB, obj MaterializeObject, indexDefault bool, ob
 	}
 }
 
+func (b *IndexBuilder) Name() string {
+	return b.indexName
+}
+
 func (b *IndexBuilder) QualifiedName() string {
 	return QualifiedName(b.objName.DatabaseName, b.objName.SchemaName, b.indexName)
 }
@@ -67,6 +71,9 @@ func (b *IndexBuilder) Create() error {
 
 	if b.indexDefault {
 		q.WriteString(` DEFAULT INDEX`)
+		if b.indexName != "" {
+			q.WriteString(fmt.Sprintf(` %s`, b.indexName))
+		}
 	} else {
 		q.WriteString(fmt.Sprintf(` INDEX %s`, b.indexName))
 	}
@@ -102,6 +109,11 @@ func (b *IndexBuilder) Create() error {
 	return b.ddl.exec(q.String())
 }
 
+func (b *IndexBuilder) Rename(newName string) error {
+	q := fmt.Sprintf(`ALTER INDEX %s RENAME TO %s;`, b.QualifiedName(), QuoteIdentifier(newName))
+	return b.ddl.exec(q)
+}
+
 func (b *IndexBuilder) Drop() error {
 	q := fmt.Sprintf(`DROP INDEX %s RESTRICT;`, b.QualifiedName())
 	return b.ddl.exec(q)
@@ -120,7 +132,9 @@ type IndexParams struct {
 	ObjectName         sql.NullString `db:"obj_name"`
 	ObjectSchemaName   sql.NullString `db:"obj_schema_name"`
 	ObjectDatabaseName sql.NullString `db:"obj_database_name"`
+	ClusterName        sql.NullString `db:"cluster_name"`
 	Comment            sql.NullString `db:"comment"`
+	OwnerName          sql.NullString `db:"owner_name"`
 }
 
 var indexQuery = NewBaseQuery(`
@@ -130,27 +144,35 @@ var indexQuery = NewBaseQuery(`
 		mz_objects.name AS obj_name,
 		mz_schemas.name AS obj_schema_name,
 		mz_databases.name AS obj_database_name,
-		comments.comment AS comment
+		mz_clusters.name AS cluster_name,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name
 	FROM mz_indexes
 	JOIN mz_objects
 		ON mz_indexes.on_id = mz_objects.id
+	JOIN mz_roles
+		ON mz_indexes.owner_id = mz_roles.id
 	LEFT JOIN mz_schemas
 		ON mz_objects.schema_id = mz_schemas.id
 	LEFT JOIN mz_databases
 		ON mz_schemas.database_id = mz_databases.id
+	LEFT JOIN mz_clusters
+		ON mz_indexes.cluster_id = mz_clusters.id
 	LEFT JOIN (
 		SELECT id, comment
 		FROM mz_internal.mz_comments
 		WHERE object_type = 'index'
 	) comments
-		ON mz_indexes.id = comments.id`).
-	CustomPredicate([]string{"mz_objects.type IN ('source', 'view', 'materialized-view')"})
+		ON mz_indexes.id = comments.id`)
+
+// Indexes can only be created on sources, views and materialized views
+var indexObjectTypes = In("mz_objects.type", "source", "view", "materialized-view")
 
-func IndexId(conn *sqlx.DB, indexName string) (string, error) {
-	q := indexQuery.QueryPredicate(map[string]string{"mz_indexes.name": indexName})
+func IndexId(conn *sqlx.DB, indexName string, filters ...Predicate) (string, error) {
+	q, args := indexQuery.Query(append([]Predicate{indexObjectTypes, Equal("mz_indexes.name", indexName)}, filters...)...)
 
 	var c IndexParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -158,26 +180,26 @@ func IndexId(conn *sqlx.DB, indexName string) (string, error) {
 }
 
 func ScanIndex(conn *sqlx.DB, id string) (IndexParams, error) {
-	q := indexQuery.QueryPredicate(map[string]string{"mz_indexes.id": id})
+	q, args := indexQuery.Query(indexObjectTypes, Equal("mz_indexes.id", id))
 
 	var c IndexParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListIndexes(conn *sqlx.DB, schemaName, databaseName string) ([]IndexParams, error) {
+func ListIndexes(conn *sqlx.DB, schemaName, databaseName string, filters ...Predicate) ([]IndexParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
 	}
 
-	q := indexQuery.QueryPredicate(p)
+	q, args := indexQuery.Query(append(append(EqualPredicates(p), indexObjectTypes), filters...)...)
 
 	var c []IndexParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
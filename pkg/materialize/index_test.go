@@ -36,6 +36,23 @@ func TestIndexDefaultCreate(t *testing.T) {
 			`CREATE DEFAULT INDEX IN CLUSTER cluster ON "database"."schema"."source" USING ARRANGEMENT \(\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
+		o := MaterializeObject{}
+		b := NewIndexBuilder(db, o, true, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
+		b.ClusterName("cluster")
+		b.Method("ARRANGEMENT")
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestIndexDefaultCreateName(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE DEFAULT INDEX index IN CLUSTER cluster ON "database"."schema"."source" USING ARRANGEMENT \(\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
 		o := MaterializeObject{Name: "index"}
 		b := NewIndexBuilder(db, o, true, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
 		b.ClusterName("cluster")
@@ -59,6 +76,18 @@ func TestIndexDrop(t *testing.T) {
 	})
 }
 
+func TestIndexRename(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER INDEX "database"."schema"."index_tf_move" RENAME TO "index";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "index_tf_move"}
+		b := NewIndexBuilder(db, o, false, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
+		if err := b.Rename("index"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestIndexComment(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`COMMENT ON INDEX "database"."schema"."index" IS 'comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -0,0 +1,130 @@
+package materialize
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The compaction window of objects without a configured retain history
+const DefaultRetainHistory = time.Second
+
+var intervalUnits = map[string]time.Duration{
+	"ms":           time.Millisecond,
+	"msec":         time.Millisecond,
+	"msecs":        time.Millisecond,
+	"millisecond":  time.Millisecond,
+	"milliseconds": time.Millisecond,
+	"s":            time.Second,
+	"sec":          time.Second,
+	"secs":         time.Second,
+	"second":       time.Second,
+	"seconds":      time.Second,
+	"m":            time.Minute,
+	"min":          time.Minute,
+	"mins":         time.Minute,
+	"minute":       time.Minute,
+	"minutes":      time.Minute,
+	"h":            time.Hour,
+	"hr":           time.Hour,
+	"hrs":          time.Hour,
+	"hour":         time.Hour,
+	"hours":        time.Hour,
+	"d":            24 * time.Hour,
+	"day":          24 * time.Hour,
+	"days":         24 * time.Hour,
+	"w":            7 * 24 * time.Hour,
+	"week":         7 * 24 * time.Hour,
+	"weeks":        7 * 24 * time.Hour,
+}
+
+var (
+	intervalPart = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([a-z]+)`)
+	intervalTime = regexp.MustCompile(`^(\d+):(\d{2})(?::(\d{2}(?:\.\d+)?))?`)
+)
+
+// Parses a fixed length interval such as `1 day`, `1hr 30min` or `01:30:00`.
+// Months and years have no fixed length and are not supported
+func ParseInterval(s string) (time.Duration, error) {
+	r := strings.ToLower(strings.TrimSpace(s))
+	if r == "" {
+		return 0, fmt.Errorf("empty interval")
+	}
+
+	var d time.Duration
+	for r != "" {
+		if m := intervalTime.FindStringSubmatch(r); m != nil {
+			h, _ := strconv.Atoi(m[1])
+			min, _ := strconv.Atoi(m[2])
+			d += time.Duration(h)*time.Hour + time.Duration(min)*time.Minute
+			if m[3] != "" {
+				sec, _ := strconv.ParseFloat(m[3], 64)
+				d += time.Duration(sec * float64(time.Second))
+			}
+			r = strings.TrimSpace(r[len(m[0]):])
+			continue
+		}
+
+		m := intervalPart.FindStringSubmatch(r)
+		if m == nil {
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+
+		unit, ok := intervalUnits[m[2]]
+		if !ok {
+			return 0, fmt.Errorf("invalid interval %q: unsupported unit %q", s, m[2])
+		}
+
+		n, _ := strconv.ParseFloat(m[1], 64)
+		d += time.Duration(n * float64(unit))
+		r = strings.TrimSpace(r[len(m[0]):])
+	}
+
+	return d, nil
+}
+
+// Formats the duration using the largest unit that represents it exactly
+func FormatInterval(d time.Duration) string {
+	units := []struct {
+		name string
+		unit time.Duration
+	}{
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+		{"second", time.Second},
+	}
+
+	for _, u := range units {
+		if d >= u.unit && d%u.unit == 0 {
+			n := int64(d / u.unit)
+			if n == 1 {
+				return fmt.Sprintf("1 %s", u.name)
+			}
+			return fmt.Sprintf("%d %ss", n, u.name)
+		}
+	}
+
+	return fmt.Sprintf("%d milliseconds", d.Milliseconds())
+}
+
+// Whether both intervals parse to the same duration
+func EquivalentIntervals(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	da, err := ParseInterval(a)
+	if err != nil {
+		return false
+	}
+
+	db, err := ParseInterval(b)
+	if err != nil {
+		return false
+	}
+
+	return da == db
+}
@@ -0,0 +1,52 @@
+package materialize
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterval(t *testing.T) {
+	r := require.New(t)
+
+	cases := map[string]time.Duration{
+		"1 day":        24 * time.Hour,
+		"1d":           24 * time.Hour,
+		"1hr":          time.Hour,
+		"1 hour 30min": 90 * time.Minute,
+		"01:30:00":     90 * time.Minute,
+		"1 day 02:00":  26 * time.Hour,
+		"1.5 hours":    90 * time.Minute,
+		"500ms":        500 * time.Millisecond,
+		"2 weeks":      14 * 24 * time.Hour,
+	}
+	for s, e := range cases {
+		d, err := ParseInterval(s)
+		r.NoError(err, s)
+		r.Equal(e, d, s)
+	}
+
+	for _, s := range []string{"", "1 month", "soon"} {
+		_, err := ParseInterval(s)
+		r.Error(err, s)
+	}
+}
+
+func TestFormatInterval(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("1 day", FormatInterval(24*time.Hour))
+	r.Equal("90 minutes", FormatInterval(90*time.Minute))
+	r.Equal("1 second", FormatInterval(time.Second))
+	r.Equal("1500 milliseconds", FormatInterval(1500*time.Millisecond))
+}
+
+func TestEquivalentIntervals(t *testing.T) {
+	r := require.New(t)
+
+	r.True(EquivalentIntervals("1 day", "24 hours"))
+	r.True(EquivalentIntervals("1hr", "01:00:00"))
+	r.False(EquivalentIntervals("1 day", "1 hour"))
+	r.False(EquivalentIntervals("1 month", "30 days"))
+}
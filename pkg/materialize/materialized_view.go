@@ -8,6 +8,18 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+type RefreshEveryStruct struct {
+	Interval  string
+	AlignedTo string
+}
+
+type RefreshStruct struct {
+	OnCommit   bool
+	AtCreation bool
+	At         []string
+	Every      []RefreshEveryStruct
+}
+
 type MaterializedViewBuilder struct {
 	ddl                  Builder
 	materializedViewName string
@@ -15,6 +27,8 @@ type MaterializedViewBuilder struct {
 	databaseName         string
 	clusterName          string
 	notNullAssertions    []string
+	refresh              RefreshStruct
+	retainHistory        string
 	selectStmt           string
 }
 
@@ -41,31 +55,88 @@ func (b *MaterializedViewBuilder) NotNullAssertions(notNullAssertions []string)
 	return b
 }
 
+func (b *MaterializedViewBuilder) Refresh(refresh RefreshStruct) *MaterializedViewBuilder {
+	b.refresh = refresh
+	return b
+}
+
+func (b *MaterializedViewBuilder) RetainHistory(retainHistory string) *MaterializedViewBuilder {
+	b.retainHistory = retainHistory
+	return b
+}
+
 func (b *MaterializedViewBuilder) SelectStmt(selectStmt string) *MaterializedViewBuilder {
 	b.selectStmt = selectStmt
 	return b
 }
 
-func (b *MaterializedViewBuilder) Create() error {
+func (b *MaterializedViewBuilder) create(replace bool) string {
 	q := strings.Builder{}
 
-	q.WriteString(fmt.Sprintf(`CREATE MATERIALIZED VIEW %s`, b.QualifiedName()))
+	q.WriteString(`CREATE`)
+	if replace {
+		q.WriteString(` OR REPLACE`)
+	}
+	q.WriteString(fmt.Sprintf(` MATERIALIZED VIEW %s`, b.QualifiedName()))
 
 	if b.clusterName != "" {
 		q.WriteString(fmt.Sprintf(` IN CLUSTER %s`, QuoteIdentifier(b.clusterName)))
 	}
 
-	if len(b.notNullAssertions) > 0 {
-		var na []string
-		for _, n := range b.notNullAssertions {
-			f := fmt.Sprintf("ASSERT NOT NULL %s", QuoteIdentifier(n))
-			na = append(na, f)
+	var w []string
+	for _, n := range b.notNullAssertions {
+		w = append(w, fmt.Sprintf("ASSERT NOT NULL %s", QuoteIdentifier(n)))
+	}
+
+	if b.refresh.OnCommit {
+		w = append(w, "REFRESH ON COMMIT")
+	}
+
+	if b.refresh.AtCreation {
+		w = append(w, "REFRESH AT CREATION")
+	}
+
+	for _, a := range b.refresh.At {
+		w = append(w, fmt.Sprintf("REFRESH AT %s", QuoteString(a)))
+	}
+
+	for _, e := range b.refresh.Every {
+		f := fmt.Sprintf("REFRESH EVERY %s", QuoteString(e.Interval))
+		if e.AlignedTo != "" {
+			f += fmt.Sprintf(" ALIGNED TO %s", QuoteString(e.AlignedTo))
 		}
-		q.WriteString(fmt.Sprintf(` WITH (%s)`, strings.Join(na[:], ", ")))
+		w = append(w, f)
+	}
+
+	if b.retainHistory != "" {
+		w = append(w, fmt.Sprintf("RETAIN HISTORY FOR %s", QuoteString(b.retainHistory)))
 	}
 
-	q.WriteString(fmt.Sprintf(` AS %s;`, b.selectStmt))
-	return b.ddl.exec(q.String())
+	if len(w) > 0 {
+		q.WriteString(fmt.Sprintf(` WITH (%s)`, strings.Join(w, ", ")))
+	}
+
+	q.WriteString(` AS `)
+	return q.String()
+}
+
+func (b *MaterializedViewBuilder) Create() error {
+	q := fmt.Sprintf(`%s%s;`, b.create(false), b.selectStmt)
+	return b.ddl.exec(q)
+}
+
+// Checks the select statement without changing the materialized view, either
+// by explaining it or by replacing the materialized view in a transaction that
+// is rolled back
+func (b *MaterializedViewBuilder) Validate(method string) error {
+	switch method {
+	case ValidateNone:
+		return nil
+	case ValidateTransaction:
+		return b.ddl.validate(b.create(true), b.selectStmt, true)
+	default:
+		return b.ddl.validate(`EXPLAIN `, b.selectStmt, false)
+	}
 }
 
 func (b *MaterializedViewBuilder) Rename(newMaterializedViewName string) error {
@@ -74,6 +145,20 @@ func (b *MaterializedViewBuilder) Rename(newMaterializedViewName string) error {
 	return b.ddl.rename(old, new)
 }
 
+func (b *MaterializedViewBuilder) AlterCluster(clusterName string) error {
+	return b.ddl.alterCluster(b.QualifiedName(), clusterName)
+}
+
+func (b *MaterializedViewBuilder) AlterRetainHistory(retainHistory string) error {
+	if retainHistory == "" {
+		q := fmt.Sprintf(`ALTER MATERIALIZED VIEW %s RESET (RETAIN HISTORY);`, b.QualifiedName())
+		return b.ddl.exec(q)
+	}
+
+	q := fmt.Sprintf(`ALTER MATERIALIZED VIEW %s SET (RETAIN HISTORY FOR %s);`, b.QualifiedName(), QuoteString(retainHistory))
+	return b.ddl.exec(q)
+}
+
 func (b *MaterializedViewBuilder) Drop() error {
 	qn := b.QualifiedName()
 	return b.ddl.drop(qn)
@@ -88,6 +173,8 @@ type MaterializedViewParams struct {
 	Comment              sql.NullString `db:"comment"`
 	OwnerName            sql.NullString `db:"owner_name"`
 	Privileges           sql.NullString `db:"privileges"`
+	CreateSql            sql.NullString `db:"create_sql"`
+	RetainHistory        sql.NullString `db:"retain_history"`
 }
 
 var materializedViewQuery = NewBaseQuery(`
@@ -99,7 +186,9 @@ var materializedViewQuery = NewBaseQuery(`
 		mz_clusters.name AS cluster_name,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_materialized_views.privileges
+		mz_materialized_views.privileges,
+		mz_materialized_views.create_sql,
+		mz_history_retention_strategies.value::text AS retain_history
 	FROM mz_materialized_views
 	JOIN mz_schemas
 		ON mz_materialized_views.schema_id = mz_schemas.id
@@ -114,7 +203,9 @@ var materializedViewQuery = NewBaseQuery(`
 		FROM mz_internal.mz_comments
 		WHERE object_type = 'materialized-view'
 	) comments
-		ON mz_materialized_views.id = comments.id`)
+		ON mz_materialized_views.id = comments.id
+	LEFT JOIN mz_internal.mz_history_retention_strategies
+		ON mz_materialized_views.id = mz_history_retention_strategies.id`)
 
 func MaterializedViewId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
@@ -122,10 +213,10 @@ func MaterializedViewId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 		"mz_schemas.name":            obj.SchemaName,
 		"mz_databases.name":          obj.DatabaseName,
 	}
-	q := materializedViewQuery.QueryPredicate(p)
+	q, args := materializedViewQuery.QueryPredicate(p)
 
 	var c MaterializedViewParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -136,25 +227,58 @@ func ScanMaterializedView(conn *sqlx.DB, id string) (MaterializedViewParams, err
 	p := map[string]string{
 		"mz_materialized_views.id": id,
 	}
-	q := materializedViewQuery.QueryPredicate(p)
+	q, args := materializedViewQuery.QueryPredicate(p)
 
 	var c MaterializedViewParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListMaterializedViews(conn *sqlx.DB, schemaName, databaseName string) ([]MaterializedViewParams, error) {
+func ListMaterializedViews(conn *sqlx.DB, schemaName, databaseName string, filters ...Predicate) ([]MaterializedViewParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
 	}
-	q := materializedViewQuery.QueryPredicate(p)
+	q, args := materializedViewQuery.Query(append(EqualPredicates(p), filters...)...)
 
 	var c []MaterializedViewParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+type RefreshStrategyParams struct {
+	MaterializedViewId sql.NullString `db:"materialized_view_id"`
+	Type               sql.NullString `db:"type"`
+	Interval           sql.NullString `db:"interval"`
+	AlignedTo          sql.NullString `db:"aligned_to"`
+	At                 sql.NullString `db:"at"`
+}
+
+var refreshStrategyQuery = NewBaseQuery(`
+	SELECT
+		mz_materialized_view_refresh_strategies.materialized_view_id,
+		mz_materialized_view_refresh_strategies.type,
+		mz_materialized_view_refresh_strategies.interval::text AS interval,
+		mz_materialized_view_refresh_strategies.aligned_to::text AS aligned_to,
+		mz_materialized_view_refresh_strategies.at::text AS at
+	FROM mz_internal.mz_materialized_view_refresh_strategies`)
+
+// Lists the refresh strategies of the materialized view. Timestamps are
+// returned as milliseconds since the Unix epoch
+func ListMaterializedViewRefreshStrategies(conn *sqlx.DB, id string) ([]RefreshStrategyParams, error) {
+	p := map[string]string{
+		"mz_materialized_view_refresh_strategies.materialized_view_id": id,
+	}
+	q, args := refreshStrategyQuery.QueryPredicate(p)
+
+	var c []RefreshStrategyParams
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
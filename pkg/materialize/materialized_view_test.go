@@ -26,6 +26,91 @@ func TestMaterializedViewCreate(t *testing.T) {
 	})
 }
 
+func TestMaterializedViewCreateRefresh(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE MATERIALIZED VIEW "database"."schema"."materialized_view" IN CLUSTER "cluster" WITH \(REFRESH AT CREATION, REFRESH AT '2024-01-01 00:00:00', REFRESH EVERY '1 day' ALIGNED TO '2024-01-01 03:00:00', RETAIN HISTORY FOR '1 hour'\) AS SELECT 1 FROM t1;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewMaterializedViewBuilder(db, o)
+		b.ClusterName("cluster")
+		b.Refresh(RefreshStruct{
+			AtCreation: true,
+			At:         []string{"2024-01-01 00:00:00"},
+			Every:      []RefreshEveryStruct{{Interval: "1 day", AlignedTo: "2024-01-01 03:00:00"}},
+		})
+		b.RetainHistory("1 hour")
+		b.SelectStmt("SELECT 1 FROM t1")
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestMaterializedViewCreateRefreshOnCommit(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE MATERIALIZED VIEW "database"."schema"."materialized_view" WITH \(ASSERT NOT NULL "column_1", REFRESH ON COMMIT\) AS SELECT 1 FROM t1;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewMaterializedViewBuilder(db, o)
+		b.NotNullAssertions([]string{"column_1"})
+		b.Refresh(RefreshStruct{OnCommit: true})
+		b.SelectStmt("SELECT 1 FROM t1")
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestMaterializedViewAlterRetainHistory(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" SET \(RETAIN HISTORY FOR '2 hours'\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" RESET \(RETAIN HISTORY\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewMaterializedViewBuilder(db, o)
+		if err := b.AlterRetainHistory("2 hours"); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := b.AlterRetainHistory(""); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestListMaterializedViewRefreshStrategies(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_materialized_view_refresh_strategies.materialized_view_id = 'u1'`
+		testhelpers.MockMaterializedViewRefreshStrategyScan(mock, p)
+
+		s, err := ListMaterializedViewRefreshStrategies(db, "u1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(s) != 1 || s[0].Type.String != "every" || s[0].Interval.String != "1 day" {
+			t.Fatalf("unexpected refresh strategies: %v", s)
+		}
+	})
+}
+
+func TestMaterializedViewAlterCluster(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" SET CLUSTER "cluster";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewMaterializedViewBuilder(db, o).AlterCluster("cluster"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestMaterializedViewDrop(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP MATERIALIZED VIEW "database"."schema"."materialized_view";`).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -0,0 +1,169 @@
+package materialize
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type NetworkPolicyRuleStruct struct {
+	Name      string
+	Action    string
+	Direction string
+	Address   string
+}
+
+func GetNetworkPolicyRuleStruct(v []interface{}) []NetworkPolicyRuleStruct {
+	var rules []NetworkPolicyRuleStruct
+	for _, rule := range v {
+		r := rule.(map[string]interface{})
+		rules = append(rules, NetworkPolicyRuleStruct{
+			Name:      r["name"].(string),
+			Action:    r["action"].(string),
+			Direction: r["direction"].(string),
+			Address:   r["address"].(string),
+		})
+	}
+	return rules
+}
+
+// DDL
+type NetworkPolicyBuilder struct {
+	ddl               Builder
+	networkPolicyName string
+	rules             []NetworkPolicyRuleStruct
+}
+
+func NewNetworkPolicyBuilder(conn *sqlx.DB, obj MaterializeObject) *NetworkPolicyBuilder {
+	return &NetworkPolicyBuilder{
+		ddl:               Builder{conn, NetworkPolicy},
+		networkPolicyName: obj.Name,
+	}
+}
+
+func (b *NetworkPolicyBuilder) QualifiedName() string {
+	return QualifiedName(b.networkPolicyName)
+}
+
+func (b *NetworkPolicyBuilder) Rules(r []NetworkPolicyRuleStruct) *NetworkPolicyBuilder {
+	b.rules = r
+	return b
+}
+
+// Rules are ordered by name so the statement does not depend on the order of
+// the rules in the configuration
+func (b *NetworkPolicyBuilder) rulesClause() string {
+	rules := make([]NetworkPolicyRuleStruct, len(b.rules))
+	copy(rules, b.rules)
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+
+	var r []string
+	for _, rule := range rules {
+		f := fmt.Sprintf(`%s (ACTION = %s, DIRECTION = %s, ADDRESS = %s)`,
+			QuoteIdentifier(rule.Name),
+			QuoteString(rule.Action),
+			QuoteString(rule.Direction),
+			QuoteString(rule.Address),
+		)
+		r = append(r, f)
+	}
+
+	return fmt.Sprintf(`RULES (%s)`, strings.Join(r, ", "))
+}
+
+func (b *NetworkPolicyBuilder) Create() error {
+	q := fmt.Sprintf(`CREATE NETWORK POLICY %s (%s);`, b.QualifiedName(), b.rulesClause())
+	return b.ddl.exec(q)
+}
+
+// Replaces all rules of the network policy
+func (b *NetworkPolicyBuilder) Alter() error {
+	q := fmt.Sprintf(`ALTER NETWORK POLICY %s SET (%s);`, b.QualifiedName(), b.rulesClause())
+	return b.ddl.exec(q)
+}
+
+func (b *NetworkPolicyBuilder) Drop() error {
+	qn := b.QualifiedName()
+	return b.ddl.drop(qn)
+}
+
+// DML
+type NetworkPolicyParams struct {
+	NetworkPolicyId   sql.NullString `db:"id"`
+	NetworkPolicyName sql.NullString `db:"name"`
+	OwnerName         sql.NullString `db:"owner_name"`
+	Privileges        sql.NullString `db:"privileges"`
+}
+
+var networkPolicyQuery = NewBaseQuery(`
+	SELECT
+		mz_network_policies.id,
+		mz_network_policies.name,
+		mz_roles.name AS owner_name,
+		mz_network_policies.privileges
+	FROM mz_internal.mz_network_policies
+	JOIN mz_roles
+		ON mz_network_policies.owner_id = mz_roles.id`)
+
+func NetworkPolicyId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+	q, args := networkPolicyQuery.QueryPredicate(map[string]string{"mz_network_policies.name": obj.Name})
+
+	var c NetworkPolicyParams
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
+		return "", err
+	}
+
+	return c.NetworkPolicyId.String, nil
+}
+
+func ScanNetworkPolicy(conn *sqlx.DB, id string) (NetworkPolicyParams, error) {
+	q, args := networkPolicyQuery.QueryPredicate(map[string]string{"mz_network_policies.id": id})
+
+	var c NetworkPolicyParams
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+type NetworkPolicyRuleParams struct {
+	Name      sql.NullString `db:"name"`
+	PolicyId  sql.NullString `db:"policy_id"`
+	Action    sql.NullString `db:"action"`
+	Direction sql.NullString `db:"direction"`
+	Address   sql.NullString `db:"address"`
+}
+
+var networkPolicyRuleQuery = NewBaseQuery(`
+	SELECT
+		mz_network_policy_rules.name,
+		mz_network_policy_rules.policy_id,
+		mz_network_policy_rules.action,
+		mz_network_policy_rules.direction,
+		mz_network_policy_rules.address
+	FROM mz_internal.mz_network_policy_rules`).Order("mz_network_policy_rules.name")
+
+func ListNetworkPolicyRules(conn *sqlx.DB, policyId string) ([]NetworkPolicyRuleParams, error) {
+	q, args := networkPolicyRuleQuery.QueryPredicate(map[string]string{"mz_network_policy_rules.policy_id": policyId})
+
+	var c []NetworkPolicyRuleParams
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// The network policy enforced for the region, set with the `network_policy`
+// system variable
+func ActiveNetworkPolicyName(conn *sqlx.DB) (string, error) {
+	var name string
+	if err := getWithRetry(conn, &name, `SHOW network_policy;`); err != nil {
+		return "", err
+	}
+	return name, nil
+}
@@ -0,0 +1,59 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+var networkPolicyRules = []NetworkPolicyRuleStruct{
+	{Name: "new_york", Action: "allow", Direction: "ingress", Address: "1.2.3.4/28"},
+	{Name: "minnesota", Action: "allow", Direction: "ingress", Address: "2.3.4.5/32"},
+}
+
+func TestNetworkPolicyCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE NETWORK POLICY "office_access_policy" \(RULES \("minnesota" \(ACTION = 'allow', DIRECTION = 'ingress', ADDRESS = '2.3.4.5/32'\), "new_york" \(ACTION = 'allow', DIRECTION = 'ingress', ADDRESS = '1.2.3.4/28'\)\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "office_access_policy"}
+		b := NewNetworkPolicyBuilder(db, o)
+		b.Rules(networkPolicyRules)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestNetworkPolicyAlter(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER NETWORK POLICY "office_access_policy" SET \(RULES \("new_york" \(ACTION = 'allow', DIRECTION = 'ingress', ADDRESS = '1.2.3.4/28'\)\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "office_access_policy"}
+		b := NewNetworkPolicyBuilder(db, o)
+		b.Rules(networkPolicyRules[:1])
+
+		if err := b.Alter(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestNetworkPolicyDrop(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`DROP NETWORK POLICY "office_access_policy";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "office_access_policy"}
+		if err := NewNetworkPolicyBuilder(db, o).Drop(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
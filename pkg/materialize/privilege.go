@@ -90,6 +90,49 @@ func ParsePrivileges(privileges string) map[string][]string {
 	return o
 }
 
+// An entry of the access control list of an object. Role ids are `p` for the
+// PUBLIC pseudo-role
+type AclItem struct {
+	Grantee    string
+	Grantor    string
+	Privileges []string
+}
+
+// Parses the access control list of an object, keeping the grantor of each
+// entry that ParsePrivileges discards
+func ParseAclItems(privileges string) []AclItem {
+	o := []AclItem{}
+
+	privileges = strings.TrimPrefix(privileges, "{")
+	privileges = strings.TrimSuffix(privileges, "}")
+	if privileges == "" {
+		return o
+	}
+
+	for _, p := range strings.Split(privileges, ",") {
+		e := strings.SplitN(p, "=", 2)
+		if len(e) != 2 {
+			continue
+		}
+
+		r := strings.SplitN(e[1], "/", 2)
+		item := AclItem{Grantee: e[0], Privileges: []string{}}
+		if len(r) == 2 {
+			item.Grantor = r[1]
+		}
+
+		for _, rp := range strings.Split(r[0], "") {
+			if v, ok := Permissions[rp]; ok {
+				item.Privileges = append(item.Privileges, v)
+			}
+		}
+
+		o = append(o, item)
+	}
+
+	return o
+}
+
 func HasPrivilege(privileges []string, checkPrivilege string) bool {
 	for _, v := range privileges {
 		if v == checkPrivilege {
@@ -104,7 +147,11 @@ type MaterializeRole struct {
 	name string
 }
 
+// The PUBLIC pseudo-role is a keyword and cannot be quoted
 func (b *MaterializeRole) QualifiedName() string {
+	if b.name == "PUBLIC" {
+		return "PUBLIC"
+	}
 	return QualifiedName(b.name)
 }
 
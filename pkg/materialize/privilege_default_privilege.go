@@ -129,10 +129,10 @@ func ScanDefaultPrivilege(conn *sqlx.DB, objectType, granteeId, targetRoleId, da
 		p["mz_default_privileges.schema_id"] = schemaId
 	}
 
-	q := defaultPrivilegeQuery.QueryPredicate(p)
+	q, args := defaultPrivilegeQuery.QueryPredicate(p)
 
 	var c []DefaultPrivilegeParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -54,10 +54,10 @@ func ScanRolePrivilege(conn *sqlx.DB, roleId, memberId string) ([]RolePrivilegeP
 		"mz_role_members.member":  memberId,
 	}
 
-	q := rolePrivilegeQuery.QueryPredicate(p)
+	q, args := rolePrivilegeQuery.QueryPredicate(p)
 
 	var c []RolePrivilegeParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
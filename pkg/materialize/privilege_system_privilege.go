@@ -44,7 +44,7 @@ var systemPrivilegeQuery = `SELECT privileges FROM mz_system_privileges`
 
 func ScanSystemPrivileges(conn *sqlx.DB) ([]SytemPrivilegeParams, error) {
 	var c []SytemPrivilegeParams
-	if err := conn.Select(&c, systemPrivilegeQuery); err != nil {
+	if err := selectWithRetry(conn, &c, systemPrivilegeQuery); err != nil {
 		return c, err
 	}
 
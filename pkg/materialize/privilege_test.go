@@ -21,6 +21,24 @@ func TestParsePrivileges(t *testing.T) {
 	}
 }
 
+func TestParseAclItems(t *testing.T) {
+	o := ParseAclItems("{u18=arwd/u18,p=r/u18,u3=rw/u18}")
+	e := []AclItem{
+		{Grantee: "u18", Grantor: "u18", Privileges: []string{"INSERT", "SELECT", "UPDATE", "DELETE"}},
+		{Grantee: "p", Grantor: "u18", Privileges: []string{"SELECT"}},
+		{Grantee: "u3", Grantor: "u18", Privileges: []string{"SELECT", "UPDATE"}},
+	}
+	if !reflect.DeepEqual(o, e) {
+		t.Fatalf("unexpected acl items %v", o)
+	}
+}
+
+func TestParseAclItemsEmpty(t *testing.T) {
+	if o := ParseAclItems("{}"); len(o) != 0 {
+		t.Fatalf("unexpected acl items %v", o)
+	}
+}
+
 func TestHasPrivilege(t *testing.T) {
 	p := []string{"SELECT", "INSERT", "UPDATE"}
 
@@ -54,6 +72,17 @@ func TestPrivilegeGrant(t *testing.T) {
 	})
 }
 
+func TestPrivilegeGrantPublic(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`GRANT USAGE ON DATABASE "materialize" TO PUBLIC;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewPrivilegeBuilder(db, "PUBLIC", "USAGE", MaterializeObject{ObjectType: "DATABASE", Name: "materialize"})
+		if err := b.Grant(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestPrivilegeRevoke(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`REVOKE CREATE ON DATABASE "materialize" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -0,0 +1,149 @@
+package materialize
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log"
+	"math/rand"
+	"reflect"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type RetryPolicy struct {
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+var defaultRetryPolicy = RetryPolicy{
+	MaxRetries:     3,
+	InitialBackoff: 250 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+var retryPolicy = defaultRetryPolicy
+
+func DefaultRetryPolicy() RetryPolicy {
+	return defaultRetryPolicy
+}
+
+// Sets the policy used to retry statements and catalog queries that fail with
+// a transient error. Configured once by the provider
+func SetRetryPolicy(p RetryPolicy) {
+	retryPolicy = p
+}
+
+// SQLSTATE codes that indicate the statement can be safely retried
+var retryableCodes = map[string]bool{
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+	"53300": true, // too_many_connections
+	"57P01": true, // admin_shutdown
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+type sqlState interface {
+	SQLState() string
+}
+
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var s sqlState
+	if errors.As(err, &s) {
+		c := s.SQLState()
+		// Class 08 covers connection exceptions
+		return retryableCodes[c] || strings.HasPrefix(c, "08")
+	}
+
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// Exponential backoff with full jitter
+func backoff(attempt int) time.Duration {
+	d := retryPolicy.InitialBackoff << attempt
+	if d <= 0 || d > retryPolicy.MaxBackoff {
+		d = retryPolicy.MaxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// Reports whether the statement failed before it was sent to the server. The
+// driver only returns ErrBadConn when nothing was written to the connection
+func isUnsent(err error) bool {
+	return errors.Is(err, driver.ErrBadConn)
+}
+
+// SQLSTATE codes the server only returns when the statement was rolled back or
+// never started. A statement ended by admin_shutdown (57P01) may already have
+// been applied, it is only retried when the driver reports it as unsent
+var rejectedCodes = map[string]bool{
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+	"53300": true, // too_many_connections
+	"57P03": true, // cannot_connect_now
+}
+
+// Reports whether the statement did not run, either because it was never sent
+// or because the server rejected it without applying it
+func isRejected(err error) bool {
+	var s sqlState
+	if errors.As(err, &s) {
+		return rejectedCodes[s.SQLState()]
+	}
+	return isUnsent(err)
+}
+
+func withRetry(f func() error) error {
+	return retryWhen(isRetryable, f)
+}
+
+// DDL is not idempotent, a statement interrupted after it reached the server
+// may have been applied. Only statements that did not run are retried
+func execWithRetry(conn *sqlx.DB, statement string) error {
+	return retryWhen(isRejected, func() error {
+		_, err := conn.Exec(statement)
+		return err
+	})
+}
+
+func retryWhen(retryable func(error) bool, f func() error) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = f()
+		if !retryable(err) || attempt >= retryPolicy.MaxRetries {
+			return err
+		}
+
+		d := backoff(attempt)
+		log.Printf("[DEBUG] retrying after transient error (attempt %d of %d, waiting %s): %s", attempt+1, retryPolicy.MaxRetries, d, err)
+		time.Sleep(d)
+	}
+}
+
+func getWithRetry(conn *sqlx.DB, dest interface{}, query string, args ...interface{}) error {
+	return withRetry(func() error {
+		return conn.Get(dest, query, args...)
+	})
+}
+
+func selectWithRetry(conn *sqlx.DB, dest interface{}, query string, args ...interface{}) error {
+	return withRetry(func() error {
+		// Select appends to the destination so discard rows from a failed attempt
+		v := reflect.ValueOf(dest).Elem()
+		v.Set(reflect.Zero(v.Type()))
+		return conn.Select(dest, query, args...)
+	})
+}
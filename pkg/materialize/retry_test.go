@@ -0,0 +1,159 @@
+package materialize
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"syscall"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+type testSQLStateError struct {
+	code string
+}
+
+func (e testSQLStateError) Error() string {
+	return fmt.Sprintf("ERROR: test (SQLSTATE %s)", e.code)
+}
+
+func (e testSQLStateError) SQLState() string {
+	return e.code
+}
+
+func withTestRetryPolicy(t *testing.T) {
+	SetRetryPolicy(RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	t.Cleanup(func() { SetRetryPolicy(defaultRetryPolicy) })
+}
+
+func TestIsRetryable(t *testing.T) {
+	for _, c := range []struct {
+		err       error
+		retryable bool
+	}{
+		{testSQLStateError{"40001"}, true},
+		{testSQLStateError{"57P01"}, true},
+		{testSQLStateError{"08006"}, true},
+		{fmt.Errorf("wrapped: %w", testSQLStateError{"40001"}), true},
+		{testSQLStateError{"42P01"}, false},
+		{syscall.ECONNRESET, true},
+		{sql.ErrNoRows, false},
+		{nil, false},
+	} {
+		if r := isRetryable(c.err); r != c.retryable {
+			t.Errorf("isRetryable(%v) = %t, expected %t", c.err, r, c.retryable)
+		}
+	}
+}
+
+func TestIsUnsent(t *testing.T) {
+	for _, c := range []struct {
+		err    error
+		unsent bool
+	}{
+		{driver.ErrBadConn, true},
+		{fmt.Errorf("wrapped: %w", driver.ErrBadConn), true},
+		{testSQLStateError{"40001"}, false},
+		{syscall.ECONNRESET, false},
+		{nil, false},
+	} {
+		if r := isUnsent(c.err); r != c.unsent {
+			t.Errorf("isUnsent(%v) = %t, expected %t", c.err, r, c.unsent)
+		}
+	}
+}
+
+func TestIsRejected(t *testing.T) {
+	for _, c := range []struct {
+		err      error
+		rejected bool
+	}{
+		{driver.ErrBadConn, true},
+		{testSQLStateError{"40001"}, true},
+		{fmt.Errorf("wrapped: %w", testSQLStateError{"40001"}), true},
+		{testSQLStateError{"57P03"}, true},
+		{testSQLStateError{"57P01"}, false},
+		{testSQLStateError{"08006"}, false},
+		{syscall.ECONNRESET, false},
+		{nil, false},
+	} {
+		if r := isRejected(c.err); r != c.rejected {
+			t.Errorf("isRejected(%v) = %t, expected %t", c.err, r, c.rejected)
+		}
+	}
+}
+
+// The driver discards a bad connection, so the retry is exercised without the
+// mock database
+func TestExecRetry(t *testing.T) {
+	withTestRetryPolicy(t)
+
+	attempts := 0
+	err := retryWhen(isRejected, func() error {
+		attempts++
+		if attempts == 1 {
+			return driver.ErrBadConn
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestExecRetrySerializationFailure(t *testing.T) {
+	withTestRetryPolicy(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// The server rolled back the statement so it is run again
+		mock.ExpectExec(`CREATE SCHEMA "database"."schema";`).WillReturnError(testSQLStateError{"40001"})
+		mock.ExpectExec(`CREATE SCHEMA "database"."schema";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "schema", DatabaseName: "database"}
+		if err := NewSchemaBuilder(db, o).Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestExecNoRetry(t *testing.T) {
+	withTestRetryPolicy(t)
+
+	for _, e := range []error{testSQLStateError{"42P01"}, testSQLStateError{"57P01"}, syscall.ECONNRESET} {
+		testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+			// The statement may have been applied so it is not retried
+			mock.ExpectExec(`CREATE SCHEMA "database"."schema";`).WillReturnError(e)
+
+			o := MaterializeObject{Name: "schema", DatabaseName: "database"}
+			if err := NewSchemaBuilder(db, o).Create(); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestScanRetry(t *testing.T) {
+	withTestRetryPolicy(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`WHERE mz_schemas.id = \$1;`).WithArgs("u1").WillReturnError(syscall.ECONNRESET)
+		testhelpers.MockSchemaScan(mock, `WHERE mz_schemas.id = 'u1'`)
+
+		s, err := ScanSchema(db, "u1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s.SchemaName.String != "schema" {
+			t.Fatalf("unexpected schema: %v", s)
+		}
+	})
+}
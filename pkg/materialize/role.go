@@ -110,10 +110,10 @@ func RoleId(conn *sqlx.DB, roleName string) (string, error) {
 		return "p", nil
 	} else {
 		p := map[string]string{"mz_roles.name": roleName}
-		q := roleQuery.QueryPredicate(p)
+		q, args := roleQuery.QueryPredicate(p)
 
 		var c RoleParams
-		if err := conn.Get(&c, q); err != nil {
+		if err := getWithRetry(conn, &c, q, args...); err != nil {
 			return "", err
 		}
 
@@ -123,10 +123,10 @@ func RoleId(conn *sqlx.DB, roleName string) (string, error) {
 
 func ScanRole(conn *sqlx.DB, id string) (RoleParams, error) {
 	p := map[string]string{"mz_roles.id": id}
-	q := roleQuery.QueryPredicate(p)
+	q, args := roleQuery.QueryPredicate(p)
 
 	var c RoleParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -134,10 +134,10 @@ func ScanRole(conn *sqlx.DB, id string) (RoleParams, error) {
 }
 
 func ListRoles(conn *sqlx.DB) ([]RoleParams, error) {
-	q := roleQuery.QueryPredicate(map[string]string{})
+	q, args := roleQuery.QueryPredicate(map[string]string{})
 
 	var c []RoleParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
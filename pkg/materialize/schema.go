@@ -71,10 +71,10 @@ func SchemaId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 		"mz_schemas.name":   obj.Name,
 		"mz_databases.name": obj.DatabaseName,
 	}
-	q := schemaQuery.QueryPredicate(p)
+	q, args := schemaQuery.QueryPredicate(p)
 
 	var c SchemaParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -85,10 +85,10 @@ func ScanSchema(conn *sqlx.DB, id string) (SchemaParams, error) {
 	p := map[string]string{
 		"mz_schemas.id": id,
 	}
-	q := schemaQuery.QueryPredicate(p)
+	q, args := schemaQuery.QueryPredicate(p)
 
 	var c SchemaParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -97,10 +97,10 @@ func ScanSchema(conn *sqlx.DB, id string) (SchemaParams, error) {
 
 func ListSchemas(conn *sqlx.DB, databaseName string) ([]SchemaParams, error) {
 	p := map[string]string{"mz_databases.name": databaseName}
-	q := schemaQuery.QueryPredicate(p)
+	q, args := schemaQuery.QueryPredicate(p)
 
 	var c []SchemaParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
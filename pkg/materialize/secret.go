@@ -94,10 +94,10 @@ func SecretId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 		"mz_schemas.name":   obj.SchemaName,
 		"mz_databases.name": obj.DatabaseName,
 	}
-	q := secretQuery.QueryPredicate(p)
+	q, args := secretQuery.QueryPredicate(p)
 
 	var c SecretParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -108,25 +108,25 @@ func ScanSecret(conn *sqlx.DB, id string) (SecretParams, error) {
 	p := map[string]string{
 		"mz_secrets.id": id,
 	}
-	q := secretQuery.QueryPredicate(p)
+	q, args := secretQuery.QueryPredicate(p)
 
 	var c SecretParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListSecrets(conn *sqlx.DB, schemaName, databaseName string) ([]SecretParams, error) {
+func ListSecrets(conn *sqlx.DB, schemaName, databaseName string, filters ...Predicate) ([]SecretParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
 	}
-	q := secretQuery.QueryPredicate(p)
+	q, args := secretQuery.Query(append(EqualPredicates(p), filters...)...)
 
 	var c []SecretParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
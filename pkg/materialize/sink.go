@@ -2,6 +2,7 @@ package materialize
 
 import (
 	"database/sql"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -35,6 +36,15 @@ func (b *Sink) Resize(newSize string) error {
 	return b.ddl.resize(b.QualifiedName(), newSize)
 }
 
+func (b *Sink) AlterCluster(clusterName string) error {
+	return b.ddl.alterCluster(b.QualifiedName(), clusterName)
+}
+
+func (b *Sink) AlterFrom(from IdentifierSchemaStruct) error {
+	q := fmt.Sprintf(`ALTER SINK %s SET FROM %s;`, b.QualifiedName(), from.QualifiedName())
+	return b.ddl.exec(q)
+}
+
 func (b *Sink) Drop() error {
 	qn := b.QualifiedName()
 	return b.ddl.drop(qn)
@@ -50,8 +60,10 @@ type SinkParams struct {
 	EnvelopeType   sql.NullString `db:"envelope_type"`
 	ConnectionName sql.NullString `db:"connection_name"`
 	ClusterName    sql.NullString `db:"cluster_name"`
+	Topic          sql.NullString `db:"topic"`
 	Comment        sql.NullString `db:"comment"`
 	OwnerName      sql.NullString `db:"owner_name"`
+	CreateSql      sql.NullString `db:"create_sql"`
 }
 
 var sinkQuery = NewBaseQuery(`
@@ -65,13 +77,17 @@ var sinkQuery = NewBaseQuery(`
 		mz_sinks.envelope_type,
 		mz_connections.name as connection_name,
 		mz_clusters.name as cluster_name,
+		mz_kafka_sinks.topic,
 		comments.comment AS comment,
-		mz_roles.name AS owner_name
+		mz_roles.name AS owner_name,
+		mz_sinks.create_sql
 	FROM mz_sinks
 	JOIN mz_schemas
 		ON mz_sinks.schema_id = mz_schemas.id
 	JOIN mz_databases
 		ON mz_schemas.database_id = mz_databases.id
+	LEFT JOIN mz_kafka_sinks
+		ON mz_sinks.id = mz_kafka_sinks.id
 	LEFT JOIN mz_connections
 		ON mz_sinks.connection_id = mz_connections.id
 	LEFT JOIN mz_clusters
@@ -91,10 +107,10 @@ func SinkId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 		"mz_schemas.name":   obj.SchemaName,
 		"mz_databases.name": obj.DatabaseName,
 	}
-	q := sinkQuery.QueryPredicate(p)
+	q, args := sinkQuery.QueryPredicate(p)
 
 	var c SinkParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -102,25 +118,25 @@ func SinkId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 }
 
 func ScanSink(conn *sqlx.DB, id string) (SinkParams, error) {
-	q := sinkQuery.QueryPredicate(map[string]string{"mz_sinks.id": id})
+	q, args := sinkQuery.QueryPredicate(map[string]string{"mz_sinks.id": id})
 
 	var c SinkParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListSinks(conn *sqlx.DB, schemaName, databaseName string) ([]SinkParams, error) {
+func ListSinks(conn *sqlx.DB, schemaName, databaseName string, filters ...Predicate) ([]SinkParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
 	}
-	q := sinkQuery.QueryPredicate(p)
+	q, args := sinkQuery.Query(append(EqualPredicates(p), filters...)...)
 
 	var c []SinkParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
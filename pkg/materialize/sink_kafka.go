@@ -2,6 +2,7 @@ package materialize
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -25,15 +26,24 @@ func GetSinkKafkaEnelopeStruct(v interface{}) KafkaSinkEnvelopeStruct {
 
 type SinkKafkaBuilder struct {
 	Sink
-	clusterName     string
-	size            string
-	from            IdentifierSchemaStruct
-	kafkaConnection IdentifierSchemaStruct
-	topic           string
-	key             []string
-	format          SinkFormatSpecStruct
-	envelope        KafkaSinkEnvelopeStruct
-	snapshot        bool
+	clusterName            string
+	size                   string
+	from                   IdentifierSchemaStruct
+	kafkaConnection        IdentifierSchemaStruct
+	topic                  string
+	compressionType        string
+	partitionBy            string
+	topicPartitionCount    int
+	topicReplicationFactor int
+	topicConfig            map[string]string
+	key                    []string
+	keyNotEnforced         bool
+	headers                string
+	format                 SinkFormatSpecStruct
+	keyFormat              SinkFormatSpecStruct
+	valueFormat            SinkFormatSpecStruct
+	envelope               KafkaSinkEnvelopeStruct
+	snapshot               bool
 }
 
 func NewSinkKafkaBuilder(conn *sqlx.DB, obj MaterializeObject) *SinkKafkaBuilder {
@@ -68,16 +78,61 @@ func (b *SinkKafkaBuilder) Topic(t string) *SinkKafkaBuilder {
 	return b
 }
 
+func (b *SinkKafkaBuilder) CompressionType(c string) *SinkKafkaBuilder {
+	b.compressionType = c
+	return b
+}
+
+func (b *SinkKafkaBuilder) PartitionBy(p string) *SinkKafkaBuilder {
+	b.partitionBy = p
+	return b
+}
+
+func (b *SinkKafkaBuilder) TopicPartitionCount(c int) *SinkKafkaBuilder {
+	b.topicPartitionCount = c
+	return b
+}
+
+func (b *SinkKafkaBuilder) TopicReplicationFactor(r int) *SinkKafkaBuilder {
+	b.topicReplicationFactor = r
+	return b
+}
+
+func (b *SinkKafkaBuilder) TopicConfig(c map[string]string) *SinkKafkaBuilder {
+	b.topicConfig = c
+	return b
+}
+
 func (b *SinkKafkaBuilder) Key(k []string) *SinkKafkaBuilder {
 	b.key = k
 	return b
 }
 
+func (b *SinkKafkaBuilder) KeyNotEnforced(k bool) *SinkKafkaBuilder {
+	b.keyNotEnforced = k
+	return b
+}
+
+func (b *SinkKafkaBuilder) Headers(h string) *SinkKafkaBuilder {
+	b.headers = h
+	return b
+}
+
 func (b *SinkKafkaBuilder) Format(f SinkFormatSpecStruct) *SinkKafkaBuilder {
 	b.format = f
 	return b
 }
 
+func (b *SinkKafkaBuilder) KeyFormat(f SinkFormatSpecStruct) *SinkKafkaBuilder {
+	b.keyFormat = f
+	return b
+}
+
+func (b *SinkKafkaBuilder) ValueFormat(f SinkFormatSpecStruct) *SinkKafkaBuilder {
+	b.valueFormat = f
+	return b
+}
+
 func (b *SinkKafkaBuilder) Envelope(e KafkaSinkEnvelopeStruct) *SinkKafkaBuilder {
 	b.envelope = e
 	return b
@@ -106,23 +161,60 @@ func (b *SinkKafkaBuilder) Create() error {
 	if len(b.key) > 0 {
 		o := strings.Join(b.key[:], ", ")
 		q.WriteString(fmt.Sprintf(` KEY (%s)`, o))
+
+		if b.keyNotEnforced {
+			q.WriteString(` NOT ENFORCED`)
+		}
 	}
 
+	// Topic options
+	var t []string
 	if b.topic != "" {
-		q.WriteString(fmt.Sprintf(` (TOPIC %s)`, QuoteString(b.topic)))
+		t = append(t, fmt.Sprintf(`TOPIC %s`, QuoteString(b.topic)))
 	}
 
-	if b.format.Json {
-		q.WriteString(` FORMAT JSON`)
+	if b.compressionType != "" {
+		t = append(t, fmt.Sprintf(`COMPRESSION TYPE = %s`, QuoteString(b.compressionType)))
 	}
 
-	if b.format.Avro != nil {
-		if b.format.Avro.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, b.format.Avro.SchemaRegistryConnection.QualifiedName()))
+	if b.partitionBy != "" {
+		t = append(t, fmt.Sprintf(`PARTITION BY = %s`, b.partitionBy))
+	}
+
+	if b.topicPartitionCount > 0 {
+		t = append(t, fmt.Sprintf(`TOPIC PARTITION COUNT = %d`, b.topicPartitionCount))
+	}
+
+	if b.topicReplicationFactor > 0 {
+		t = append(t, fmt.Sprintf(`TOPIC REPLICATION FACTOR = %d`, b.topicReplicationFactor))
+	}
+
+	if len(b.topicConfig) > 0 {
+		var keys []string
+		for k := range b.topicConfig {
+			keys = append(keys, k)
 		}
-		if b.format.Avro.AvroValueFullname != "" && b.format.Avro.AvroKeyFullname != "" {
-			q.WriteString(fmt.Sprintf(` WITH (AVRO KEY FULLNAME %s AVRO VALUE FULLNAME %s)`, QuoteString(b.format.Avro.AvroKeyFullname), QuoteString(b.format.Avro.AvroValueFullname)))
+		sort.Strings(keys)
+
+		var c []string
+		for _, k := range keys {
+			c = append(c, fmt.Sprintf(`%s => %s`, QuoteString(k), QuoteString(b.topicConfig[k])))
 		}
+		t = append(t, fmt.Sprintf(`TOPIC CONFIG = MAP[%s]`, strings.Join(c, ", ")))
+	}
+
+	if len(t) > 0 {
+		q.WriteString(fmt.Sprintf(` (%s)`, strings.Join(t, ", ")))
+	}
+
+	if b.headers != "" {
+		q.WriteString(fmt.Sprintf(` HEADERS %s`, b.headers))
+	}
+
+	if !b.keyFormat.empty() && !b.valueFormat.empty() {
+		q.WriteString(fmt.Sprintf(` KEY FORMAT %s VALUE FORMAT %s`, b.keyFormat.sinkFormat(), b.valueFormat.sinkFormat()))
+	} else if !b.format.empty() {
+		q.WriteString(fmt.Sprintf(` FORMAT %s`, b.format.sinkFormat()))
 	}
 
 	if b.envelope.Debezium {
@@ -30,3 +30,89 @@ func TestSinkKafkaCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestSinkKafkaTopicOptionsCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SINK "database"."schema"."sink" IN CLUSTER "cluster" FROM "database"."schema"."table" INTO KAFKA CONNECTION "database"."schema"."kafka_connection" \(TOPIC 'topic', COMPRESSION TYPE = 'zstd', PARTITION BY = seahash\(id::text\), TOPIC PARTITION COUNT = 6, TOPIC REPLICATION FACTOR = 3, TOPIC CONFIG = MAP\['cleanup.policy' => 'compact', 'retention.ms' => '86400000'\]\) FORMAT JSON ENVELOPE DEBEZIUM;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
+		b := NewSinkKafkaBuilder(db, o)
+		b.ClusterName("cluster")
+		b.From(IdentifierSchemaStruct{Name: "table", SchemaName: "schema", DatabaseName: "database"})
+		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_connection", SchemaName: "schema", DatabaseName: "database"})
+		b.Topic("topic")
+		b.CompressionType("zstd")
+		b.PartitionBy("seahash(id::text)")
+		b.TopicPartitionCount(6)
+		b.TopicReplicationFactor(3)
+		b.TopicConfig(map[string]string{"retention.ms": "86400000", "cleanup.policy": "compact"})
+		b.Format(SinkFormatSpecStruct{Json: true})
+		b.Envelope(KafkaSinkEnvelopeStruct{Debezium: true})
+		b.Snapshot(true)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSinkKafkaKeyValueFormatCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SINK "database"."schema"."sink" IN CLUSTER "cluster" FROM "database"."schema"."table" INTO KAFKA CONNECTION "database"."schema"."kafka_connection" KEY \(id\) NOT ENFORCED \(TOPIC 'topic'\) HEADERS headers KEY FORMAT TEXT VALUE FORMAT BYTES ENVELOPE UPSERT;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
+		b := NewSinkKafkaBuilder(db, o)
+		b.ClusterName("cluster")
+		b.From(IdentifierSchemaStruct{Name: "table", SchemaName: "schema", DatabaseName: "database"})
+		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_connection", SchemaName: "schema", DatabaseName: "database"})
+		b.Topic("topic")
+		b.Key([]string{"id"})
+		b.KeyNotEnforced(true)
+		b.Headers("headers")
+		b.KeyFormat(SinkFormatSpecStruct{Text: true})
+		b.ValueFormat(SinkFormatSpecStruct{Bytes: true})
+		b.Envelope(KafkaSinkEnvelopeStruct{Upsert: true})
+		b.Snapshot(true)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSinkKafkaAvroDocCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SINK "database"."schema"."sink" IN CLUSTER "cluster" FROM "database"."schema"."table" INTO KAFKA CONNECTION "database"."schema"."kafka_connection" \(TOPIC 'topic'\) FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection" WITH \(DOC ON TYPE "database"."schema"."table" = 'table doc', KEY DOC ON COLUMN "database"."schema"."table"."id" = 'key doc', VALUE DOC ON COLUMN "database"."schema"."table"."id" = 'value doc'\) ENVELOPE DEBEZIUM;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		table := IdentifierSchemaStruct{Name: "table", SchemaName: "schema", DatabaseName: "database"}
+
+		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
+		b := NewSinkKafkaBuilder(db, o)
+		b.ClusterName("cluster")
+		b.From(table)
+		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_connection", SchemaName: "schema", DatabaseName: "database"})
+		b.Topic("topic")
+		b.Format(SinkFormatSpecStruct{
+			Avro: &SinkAvroFormatSpec{
+				SchemaRegistryConnection: IdentifierSchemaStruct{Name: "csr_connection", SchemaName: "schema", DatabaseName: "database"},
+				DocType:                  []AvroDocType{{Object: table, Doc: "table doc"}},
+				DocColumn: []AvroDocColumn{
+					{Object: table, Column: "id", Doc: "key doc", Key: true},
+					{Object: table, Column: "id", Doc: "value doc", Value: true},
+				},
+			},
+		})
+		b.Envelope(KafkaSinkEnvelopeStruct{Debezium: true})
+		b.Snapshot(true)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestSinkAlterCluster(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SINK "database"."schema"."sink" SET CLUSTER "cluster";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewSink(db, o).AlterCluster("cluster"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSinkAlterFrom(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SINK "database"."schema"."sink" SET FROM "database"."schema"."table_v2";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
+		from := IdentifierSchemaStruct{Name: "table_v2", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewSink(db, o).AlterFrom(from); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -110,6 +110,7 @@ type SourceParams struct {
 	Comment        sql.NullString `db:"comment"`
 	OwnerName      sql.NullString `db:"owner_name"`
 	Privileges     sql.NullString `db:"privileges"`
+	CreateSql      sql.NullString `db:"create_sql"`
 }
 
 var sourceQuery = NewBaseQuery(`
@@ -125,7 +126,8 @@ var sourceQuery = NewBaseQuery(`
 			mz_clusters.name as cluster_name,
 			comments.comment AS comment,
 			mz_roles.name AS owner_name,
-			mz_sources.privileges
+			mz_sources.privileges,
+			mz_sources.create_sql
 		FROM mz_sources
 		JOIN mz_schemas
 			ON mz_sources.schema_id = mz_schemas.id
@@ -150,10 +152,10 @@ func SourceId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 		"mz_schemas.name":   obj.SchemaName,
 		"mz_databases.name": obj.DatabaseName,
 	}
-	q := sourceQuery.QueryPredicate(p)
+	q, args := sourceQuery.QueryPredicate(p)
 
 	var c SourceParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -161,25 +163,25 @@ func SourceId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 }
 
 func ScanSource(conn *sqlx.DB, id string) (SourceParams, error) {
-	q := sourceQuery.QueryPredicate(map[string]string{"mz_sources.id": id})
+	q, args := sourceQuery.QueryPredicate(map[string]string{"mz_sources.id": id})
 
 	var c SourceParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListSources(conn *sqlx.DB, schemaName, databaseName string) ([]SourceParams, error) {
+func ListSources(conn *sqlx.DB, schemaName, databaseName string, filters ...Predicate) ([]SourceParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
 	}
-	q := sourceQuery.QueryPredicate(p)
+	q, args := sourceQuery.Query(append(EqualPredicates(p), filters...)...)
 
 	var c []SourceParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -186,141 +186,16 @@ func (b *SourceKafkaBuilder) Create() error {
 
 	q.WriteString(`)`)
 
-	// Format
-	if b.format.Avro != nil {
-		if b.format.Avro.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.format.Avro.SchemaRegistryConnection.DatabaseName, b.format.Avro.SchemaRegistryConnection.SchemaName, b.format.Avro.SchemaRegistryConnection.Name)))
-		}
-		if b.format.Avro.KeyStrategy != "" {
-			q.WriteString(fmt.Sprintf(` KEY STRATEGY %s`, b.format.Avro.KeyStrategy))
-		}
-		if b.format.Avro.ValueStrategy != "" {
-			q.WriteString(fmt.Sprintf(` VALUE STRATEGY %s`, b.format.Avro.ValueStrategy))
-		}
-	}
-
-	if b.format.Protobuf != nil {
-		if b.format.Protobuf.SchemaRegistryConnection.Name != "" && b.format.Protobuf.MessageName != "" {
-			q.WriteString(fmt.Sprintf(` FORMAT PROTOBUF MESSAGE '%s' USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, b.format.Protobuf.MessageName, QualifiedName(b.format.Protobuf.SchemaRegistryConnection.DatabaseName, b.format.Protobuf.SchemaRegistryConnection.SchemaName, b.format.Protobuf.SchemaRegistryConnection.Name)))
-		}
-
-		if b.format.Protobuf.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` FORMAT PROTOBUF USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.format.Protobuf.SchemaRegistryConnection.DatabaseName, b.format.Protobuf.SchemaRegistryConnection.SchemaName, b.format.Protobuf.SchemaRegistryConnection.Name)))
-		}
-	}
-
-	if b.format.Csv != nil {
-		if b.format.Csv.Columns > 0 {
-			q.WriteString(fmt.Sprintf(` FORMAT CSV WITH %d COLUMNS`, b.format.Csv.Columns))
-		}
-
-		if b.format.Csv.Header != nil {
-			q.WriteString(fmt.Sprintf(` FORMAT CSV WITH HEADER ( %s )`, strings.Join(b.format.Csv.Header, ", ")))
-		}
-
-		if b.format.Csv.DelimitedBy != "" {
-			q.WriteString(fmt.Sprintf(` DELIMITER '%s'`, b.format.Csv.DelimitedBy))
-		}
-	}
-
-	if b.format.Bytes {
-		q.WriteString(` FORMAT BYTES`)
-	}
-
-	if b.format.Text {
-		q.WriteString(` FORMAT TEXT`)
-	}
-
-	if b.format.Json {
-		q.WriteString(` FORMAT JSON`)
-	}
-
-	if b.keyFormat.Avro != nil {
-		if b.keyFormat.Avro.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` KEY FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.keyFormat.Avro.SchemaRegistryConnection.DatabaseName, b.keyFormat.Avro.SchemaRegistryConnection.SchemaName, b.keyFormat.Avro.SchemaRegistryConnection.Name)))
-		}
-		if b.keyFormat.Avro.KeyStrategy != "" {
-			q.WriteString(fmt.Sprintf(` KEY STRATEGY %s`, b.keyFormat.Avro.KeyStrategy))
-		}
-		if b.keyFormat.Avro.ValueStrategy != "" {
-			q.WriteString(fmt.Sprintf(` VALUE STRATEGY %s`, b.keyFormat.Avro.ValueStrategy))
-		}
-	}
-
-	if b.keyFormat.Protobuf != nil {
-		if b.keyFormat.Protobuf.SchemaRegistryConnection.Name != "" && b.keyFormat.Protobuf.MessageName != "" {
-			q.WriteString(fmt.Sprintf(` KEY FORMAT PROTOBUF MESSAGE '%s' USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, b.keyFormat.Protobuf.MessageName, QualifiedName(b.keyFormat.Protobuf.SchemaRegistryConnection.DatabaseName, b.keyFormat.Protobuf.SchemaRegistryConnection.SchemaName, b.keyFormat.Protobuf.SchemaRegistryConnection.Name)))
-		}
-
-		if b.keyFormat.Protobuf.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` KEY FORMAT PROTOBUF USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.keyFormat.Protobuf.SchemaRegistryConnection.DatabaseName, b.keyFormat.Protobuf.SchemaRegistryConnection.SchemaName, b.keyFormat.Protobuf.SchemaRegistryConnection.Name)))
-		}
-	}
-
-	if b.keyFormat.Csv != nil {
-		if b.keyFormat.Csv.Columns > 0 {
-			q.WriteString(fmt.Sprintf(` KEY FORMAT CSV WITH %d COLUMNS`, b.keyFormat.Csv.Columns))
-		}
-
-		if b.keyFormat.Csv.Header != nil {
-			q.WriteString(fmt.Sprintf(` KEY FORMAT CSV WITH HEADER ( %s )`, strings.Join(b.keyFormat.Csv.Header, ", ")))
-		}
-
-		if b.keyFormat.Csv.DelimitedBy != "" {
-			q.WriteString(fmt.Sprintf(` DELIMITER '%s'`, b.keyFormat.Csv.DelimitedBy))
-		}
-	}
-
-	if b.keyFormat.Bytes {
-		q.WriteString(` KEY FORMAT BYTES`)
-	}
-
-	if b.keyFormat.Text {
-		q.WriteString(` KEY FORMAT TEXT`)
-	}
-
-	if b.valueFormat.Avro != nil {
-		if b.valueFormat.Avro.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` VALUE FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.valueFormat.Avro.SchemaRegistryConnection.DatabaseName, b.valueFormat.Avro.SchemaRegistryConnection.SchemaName, b.valueFormat.Avro.SchemaRegistryConnection.Name)))
-		}
-		if b.valueFormat.Avro.KeyStrategy != "" {
-			q.WriteString(fmt.Sprintf(` VALUE STRATEGY %s`, b.valueFormat.Avro.KeyStrategy))
-		}
-		if b.valueFormat.Avro.ValueStrategy != "" {
-			q.WriteString(fmt.Sprintf(` VALUE STRATEGY %s`, b.valueFormat.Avro.ValueStrategy))
-		}
-	}
-
-	if b.valueFormat.Protobuf != nil {
-		if b.valueFormat.Protobuf.SchemaRegistryConnection.Name != "" && b.valueFormat.Protobuf.MessageName != "" {
-			q.WriteString(fmt.Sprintf(` VALUE FORMAT PROTOBUF MESSAGE '%s' USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, b.valueFormat.Protobuf.MessageName, QualifiedName(b.valueFormat.Protobuf.SchemaRegistryConnection.DatabaseName, b.valueFormat.Protobuf.SchemaRegistryConnection.SchemaName, b.valueFormat.Protobuf.SchemaRegistryConnection.Name)))
-		}
-
-		if b.valueFormat.Protobuf.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` VALUE FORMAT PROTOBUF USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.valueFormat.Protobuf.SchemaRegistryConnection.DatabaseName, b.valueFormat.Protobuf.SchemaRegistryConnection.SchemaName, b.valueFormat.Protobuf.SchemaRegistryConnection.Name)))
-		}
-	}
-
-	if b.valueFormat.Csv != nil {
-		if b.valueFormat.Csv.Columns > 0 {
-			q.WriteString(fmt.Sprintf(` VALUE FORMAT CSV WITH %d COLUMNS`, b.valueFormat.Csv.Columns))
-		}
-
-		if b.valueFormat.Csv.Header != nil {
-			q.WriteString(fmt.Sprintf(` VALUE FORMAT CSV WITH HEADER ( %s )`, strings.Join(b.valueFormat.Csv.Header, ", ")))
-		}
-
-		if b.valueFormat.Csv.DelimitedBy != "" {
-			q.WriteString(fmt.Sprintf(` DELIMITER '%s'`, b.valueFormat.Csv.DelimitedBy))
-		}
+	if !b.format.empty() {
+		q.WriteString(fmt.Sprintf(` FORMAT %s`, b.format.sourceFormat()))
 	}
 
-	if b.valueFormat.Bytes {
-		q.WriteString(` VALUE FORMAT BYTES`)
+	if !b.keyFormat.empty() {
+		q.WriteString(fmt.Sprintf(` KEY FORMAT %s`, b.keyFormat.sourceFormat()))
 	}
 
-	if b.valueFormat.Text {
-		q.WriteString(` VALUE FORMAT TEXT`)
+	if !b.valueFormat.empty() {
+		q.WriteString(fmt.Sprintf(` VALUE FORMAT %s`, b.valueFormat.sourceFormat()))
 	}
 
 	// Time-based Offsets
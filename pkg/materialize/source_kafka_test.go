@@ -33,3 +33,24 @@ func TestResourceSourceKafkaCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceSourceKafkaCreateKeyValueFormat(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" FROM KAFKA CONNECTION "database"."schema"."kafka_connection" \(TOPIC 'events'\) KEY FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection" KEY STRATEGY INLINE VALUE FORMAT PROTOBUF MESSAGE 'Batch' USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection" ENVELOPE UPSERT;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		csr := IdentifierSchemaStruct{Name: "csr_connection", DatabaseName: "database", SchemaName: "schema"}
+		o := MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
+		b := NewSourceKafkaBuilder(db, o)
+		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_connection", DatabaseName: "database", SchemaName: "schema"})
+		b.Topic("events")
+		b.KeyFormat(SourceFormatSpecStruct{Avro: &AvroFormatSpec{SchemaRegistryConnection: csr, KeyStrategy: "INLINE"}})
+		b.ValueFormat(SourceFormatSpecStruct{Protobuf: &ProtobufFormatSpec{SchemaRegistryConnection: csr, MessageName: "Batch"}})
+		b.Envelope(KafkaSourceEnvelopeStruct{Upsert: true})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
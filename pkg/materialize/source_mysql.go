@@ -0,0 +1,123 @@
+package materialize
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type SourceMysqlBuilder struct {
+	Source
+	clusterName     string
+	size            string
+	mysqlConnection IdentifierSchemaStruct
+	textColumns     []string
+	ignoreColumns   []string
+	table           []TableStruct
+	schema          []string
+	exposeProgress  string
+}
+
+func NewSourceMysqlBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceMysqlBuilder {
+	b := Builder{conn, BaseSource}
+	return &SourceMysqlBuilder{
+		Source: Source{b, obj.Name, obj.SchemaName, obj.DatabaseName},
+	}
+}
+
+func (b *SourceMysqlBuilder) ClusterName(c string) *SourceMysqlBuilder {
+	b.clusterName = c
+	return b
+}
+
+func (b *SourceMysqlBuilder) Size(s string) *SourceMysqlBuilder {
+	b.size = s
+	return b
+}
+
+func (b *SourceMysqlBuilder) MysqlConnection(m IdentifierSchemaStruct) *SourceMysqlBuilder {
+	b.mysqlConnection = m
+	return b
+}
+
+func (b *SourceMysqlBuilder) TextColumns(t []string) *SourceMysqlBuilder {
+	b.textColumns = t
+	return b
+}
+
+func (b *SourceMysqlBuilder) IgnoreColumns(i []string) *SourceMysqlBuilder {
+	b.ignoreColumns = i
+	return b
+}
+
+func (b *SourceMysqlBuilder) Table(t []TableStruct) *SourceMysqlBuilder {
+	b.table = t
+	return b
+}
+
+func (b *SourceMysqlBuilder) Schema(s []string) *SourceMysqlBuilder {
+	b.schema = s
+	return b
+}
+
+func (b *SourceMysqlBuilder) ExposeProgress(e string) *SourceMysqlBuilder {
+	b.exposeProgress = e
+	return b
+}
+
+func (b *SourceMysqlBuilder) Create() error {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE SOURCE %s`, b.QualifiedName()))
+
+	if b.clusterName != "" {
+		q.WriteString(fmt.Sprintf(` IN CLUSTER %s`, QuoteIdentifier(b.clusterName)))
+	}
+
+	q.WriteString(fmt.Sprintf(` FROM MYSQL CONNECTION %s`, b.mysqlConnection.QualifiedName()))
+
+	var p []string
+	if len(b.textColumns) > 0 {
+		s := strings.Join(b.textColumns, ", ")
+		p = append(p, fmt.Sprintf(`TEXT COLUMNS (%s)`, s))
+	}
+
+	if len(b.ignoreColumns) > 0 {
+		s := strings.Join(b.ignoreColumns, ", ")
+		p = append(p, fmt.Sprintf(`IGNORE COLUMNS (%s)`, s))
+	}
+
+	if len(p) > 0 {
+		q.WriteString(fmt.Sprintf(` (%s)`, strings.Join(p, ", ")))
+	}
+
+	if len(b.table) > 0 {
+		q.WriteString(` FOR TABLES (`)
+		for i, t := range b.table {
+			if t.Alias == "" {
+				t.Alias = t.Name
+			}
+			q.WriteString(fmt.Sprintf(`%s AS %s`, t.Name, t.Alias))
+			if i < len(b.table)-1 {
+				q.WriteString(`, `)
+			}
+		}
+		q.WriteString(`)`)
+	} else if len(b.schema) > 0 {
+		s := strings.Join(b.schema, ", ")
+		q.WriteString(fmt.Sprintf(` FOR SCHEMAS (%s)`, s))
+	} else {
+		q.WriteString(` FOR ALL TABLES`)
+	}
+
+	if b.exposeProgress != "" {
+		q.WriteString(fmt.Sprintf(` EXPOSE PROGRESS AS %s`, b.exposeProgress))
+	}
+
+	if b.size != "" {
+		q.WriteString(fmt.Sprintf(` WITH (SIZE = %s)`, QuoteString(b.size)))
+	}
+
+	q.WriteString(`;`)
+	return b.ddl.exec(q.String())
+}
@@ -0,0 +1,99 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+var sourceMysql = MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
+
+func TestSourceMysqlAllTablesCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM MYSQL CONNECTION "database"."schema"."mysql_connection" FOR ALL TABLES;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceMysqlBuilder(db, sourceMysql)
+		b.ClusterName("cluster")
+		b.MysqlConnection(IdentifierSchemaStruct{Name: "mysql_connection", SchemaName: "schema", DatabaseName: "database"})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceMysqlSchemasCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM MYSQL CONNECTION "database"."schema"."mysql_connection" FOR SCHEMAS \(schema_1, schema_2\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceMysqlBuilder(db, sourceMysql)
+		b.ClusterName("cluster")
+		b.Schema([]string{"schema_1", "schema_2"})
+		b.MysqlConnection(IdentifierSchemaStruct{Name: "mysql_connection", SchemaName: "schema", DatabaseName: "database"})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceMysqlSpecificTablesCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" FROM MYSQL CONNECTION "database"."schema"."mysql_connection" \(TEXT COLUMNS \(schema1.table_1.column_1, schema2.table_1.column_1\), IGNORE COLUMNS \(schema1.table_1.column_2\)\) FOR TABLES \(schema1.table_1 AS s1_table_1, schema2.table_1 AS s2_table_1\) EXPOSE PROGRESS AS progress WITH \(SIZE = 'xsmall'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceMysqlBuilder(db, sourceMysql)
+		b.Size("xsmall")
+		b.MysqlConnection(IdentifierSchemaStruct{Name: "mysql_connection", SchemaName: "schema", DatabaseName: "database"})
+		b.TextColumns([]string{"schema1.table_1.column_1", "schema2.table_1.column_1"})
+		b.IgnoreColumns([]string{"schema1.table_1.column_2"})
+		b.Table([]TableStruct{
+			{
+				Name:  "schema1.table_1",
+				Alias: "s1_table_1",
+			},
+			{
+				Name:  "schema2.table_1",
+				Alias: "s2_table_1",
+			},
+		})
+		b.ExposeProgress("progress")
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceMysqlAddSubsource(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SOURCE "database"."schema"."source" ADD SUBSOURCE "table_1", "table_2" AS "table_alias";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceMysqlBuilder(db, sourceMysql)
+		if err := b.AddSubsource(tableInput, []string{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceMysqlDropSubsource(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SOURCE "database"."schema"."source" DROP SUBSOURCE "table_1", "table_alias";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceMysqlBuilder(db, sourceMysql)
+		if err := b.DropSubsource(tableInput); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,292 @@
+package materialize
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type SourceTableIncludeStruct struct {
+	Key            bool
+	KeyAlias       string
+	Headers        bool
+	HeadersAlias   string
+	Partition      bool
+	PartitionAlias string
+	Offset         bool
+	OffsetAlias    string
+	Timestamp      bool
+	TimestampAlias string
+}
+
+// Tables created from a source with `CREATE TABLE ... FROM SOURCE`. Each
+// table ingests a single upstream reference of the source, a PostgreSQL or
+// MySQL table or the Kafka topic
+type SourceTableBuilder struct {
+	ddl                Builder
+	tableName          string
+	schemaName         string
+	databaseName       string
+	source             IdentifierSchemaStruct
+	upstreamName       string
+	upstreamSchemaName string
+	textColumns        []string
+	excludeColumns     []string
+	format             SourceFormatSpecStruct
+	keyFormat          SourceFormatSpecStruct
+	valueFormat        SourceFormatSpecStruct
+	include            SourceTableIncludeStruct
+	envelope           KafkaSourceEnvelopeStruct
+}
+
+func NewSourceTableBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceTableBuilder {
+	return &SourceTableBuilder{
+		ddl:          Builder{conn, Table},
+		tableName:    obj.Name,
+		schemaName:   obj.SchemaName,
+		databaseName: obj.DatabaseName,
+	}
+}
+
+func (b *SourceTableBuilder) QualifiedName() string {
+	return QualifiedName(b.databaseName, b.schemaName, b.tableName)
+}
+
+func (b *SourceTableBuilder) Source(s IdentifierSchemaStruct) *SourceTableBuilder {
+	b.source = s
+	return b
+}
+
+func (b *SourceTableBuilder) UpstreamName(n string) *SourceTableBuilder {
+	b.upstreamName = n
+	return b
+}
+
+func (b *SourceTableBuilder) UpstreamSchemaName(n string) *SourceTableBuilder {
+	b.upstreamSchemaName = n
+	return b
+}
+
+func (b *SourceTableBuilder) TextColumns(c []string) *SourceTableBuilder {
+	b.textColumns = c
+	return b
+}
+
+func (b *SourceTableBuilder) ExcludeColumns(c []string) *SourceTableBuilder {
+	b.excludeColumns = c
+	return b
+}
+
+func (b *SourceTableBuilder) Format(f SourceFormatSpecStruct) *SourceTableBuilder {
+	b.format = f
+	return b
+}
+
+func (b *SourceTableBuilder) KeyFormat(f SourceFormatSpecStruct) *SourceTableBuilder {
+	b.keyFormat = f
+	return b
+}
+
+func (b *SourceTableBuilder) ValueFormat(f SourceFormatSpecStruct) *SourceTableBuilder {
+	b.valueFormat = f
+	return b
+}
+
+func (b *SourceTableBuilder) Include(i SourceTableIncludeStruct) *SourceTableBuilder {
+	b.include = i
+	return b
+}
+
+func (b *SourceTableBuilder) Envelope(e KafkaSourceEnvelopeStruct) *SourceTableBuilder {
+	b.envelope = e
+	return b
+}
+
+func (b *SourceTableBuilder) reference() string {
+	if b.upstreamSchemaName != "" {
+		return QualifiedName(b.upstreamSchemaName, b.upstreamName)
+	}
+	return QuoteIdentifier(b.upstreamName)
+}
+
+func includeColumn(name, alias string) string {
+	if alias != "" {
+		return fmt.Sprintf(`%s AS %s`, name, QuoteIdentifier(alias))
+	}
+	return name
+}
+
+func (b *SourceTableBuilder) Create() error {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE TABLE %s FROM SOURCE %s (REFERENCE %s)`, b.QualifiedName(), b.source.QualifiedName(), b.reference()))
+
+	// Format
+	if !b.format.empty() {
+		q.WriteString(fmt.Sprintf(` FORMAT %s`, b.format.sourceFormat()))
+	}
+
+	if !b.keyFormat.empty() {
+		q.WriteString(fmt.Sprintf(` KEY FORMAT %s`, b.keyFormat.sourceFormat()))
+	}
+
+	if !b.valueFormat.empty() {
+		q.WriteString(fmt.Sprintf(` VALUE FORMAT %s`, b.valueFormat.sourceFormat()))
+	}
+
+	// Metadata
+	var i []string
+	if b.include.Key {
+		i = append(i, includeColumn("KEY", b.include.KeyAlias))
+	}
+	if b.include.Headers {
+		i = append(i, includeColumn("HEADERS", b.include.HeadersAlias))
+	}
+	if b.include.Partition {
+		i = append(i, includeColumn("PARTITION", b.include.PartitionAlias))
+	}
+	if b.include.Offset {
+		i = append(i, includeColumn("OFFSET", b.include.OffsetAlias))
+	}
+	if b.include.Timestamp {
+		i = append(i, includeColumn("TIMESTAMP", b.include.TimestampAlias))
+	}
+
+	if len(i) > 0 {
+		q.WriteString(fmt.Sprintf(` INCLUDE %s`, strings.Join(i, ", ")))
+	}
+
+	if b.envelope.Debezium {
+		q.WriteString(` ENVELOPE DEBEZIUM`)
+	}
+
+	if b.envelope.Upsert {
+		q.WriteString(` ENVELOPE UPSERT`)
+	}
+
+	if b.envelope.None {
+		q.WriteString(` ENVELOPE NONE`)
+	}
+
+	// Options
+	var o []string
+	if len(b.textColumns) > 0 {
+		o = append(o, fmt.Sprintf(`TEXT COLUMNS (%s)`, quoteColumns(b.textColumns)))
+	}
+
+	if len(b.excludeColumns) > 0 {
+		o = append(o, fmt.Sprintf(`EXCLUDE COLUMNS (%s)`, quoteColumns(b.excludeColumns)))
+	}
+
+	if len(o) > 0 {
+		q.WriteString(fmt.Sprintf(` WITH (%s)`, strings.Join(o, ", ")))
+	}
+
+	q.WriteString(`;`)
+	return b.ddl.exec(q.String())
+}
+
+func quoteColumns(columns []string) string {
+	var c []string
+	for _, column := range columns {
+		c = append(c, QuoteIdentifier(column))
+	}
+	return strings.Join(c, ", ")
+}
+
+func (b *SourceTableBuilder) Rename(newName string) error {
+	n := QualifiedName(newName)
+	return b.ddl.rename(b.QualifiedName(), n)
+}
+
+func (b *SourceTableBuilder) Drop() error {
+	qn := b.QualifiedName()
+	return b.ddl.drop(qn)
+}
+
+// DML
+type SourceTableParams struct {
+	TableId            sql.NullString `db:"id"`
+	TableName          sql.NullString `db:"name"`
+	SchemaName         sql.NullString `db:"schema_name"`
+	DatabaseName       sql.NullString `db:"database_name"`
+	SourceName         sql.NullString `db:"source_name"`
+	SourceSchemaName   sql.NullString `db:"source_schema_name"`
+	SourceDatabaseName sql.NullString `db:"source_database_name"`
+	SourceType         sql.NullString `db:"source_type"`
+	UpstreamName       sql.NullString `db:"upstream_name"`
+	UpstreamSchemaName sql.NullString `db:"upstream_schema_name"`
+	Comment            sql.NullString `db:"comment"`
+	OwnerName          sql.NullString `db:"owner_name"`
+	Privileges         sql.NullString `db:"privileges"`
+}
+
+var sourceTableQuery = NewBaseQuery(`
+	SELECT
+		mz_tables.id,
+		mz_tables.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_sources.name AS source_name,
+		source_schemas.name AS source_schema_name,
+		source_databases.name AS source_database_name,
+		mz_sources.type AS source_type,
+		COALESCE(mz_postgres_source_tables.table_name, mz_mysql_source_tables.table_name, mz_kafka_source_tables.topic) AS upstream_name,
+		COALESCE(mz_postgres_source_tables.schema_name, mz_mysql_source_tables.schema_name) AS upstream_schema_name,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name,
+		mz_tables.privileges
+	FROM mz_tables
+	JOIN mz_schemas
+		ON mz_tables.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_sources
+		ON mz_tables.source_id = mz_sources.id
+	JOIN mz_schemas AS source_schemas
+		ON mz_sources.schema_id = source_schemas.id
+	JOIN mz_databases AS source_databases
+		ON source_schemas.database_id = source_databases.id
+	LEFT JOIN mz_internal.mz_postgres_source_tables
+		ON mz_tables.id = mz_postgres_source_tables.table_id
+	LEFT JOIN mz_internal.mz_mysql_source_tables
+		ON mz_tables.id = mz_mysql_source_tables.table_id
+	LEFT JOIN mz_internal.mz_kafka_source_tables
+		ON mz_tables.id = mz_kafka_source_tables.table_id
+	JOIN mz_roles
+		ON mz_tables.owner_id = mz_roles.id
+	LEFT JOIN (
+		SELECT id, comment
+		FROM mz_internal.mz_comments
+		WHERE object_type = 'table'
+		AND object_sub_id IS NULL
+	) comments
+		ON mz_tables.id = comments.id`)
+
+func SourceTableId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+	p := map[string]string{
+		"mz_tables.name":    obj.Name,
+		"mz_schemas.name":   obj.SchemaName,
+		"mz_databases.name": obj.DatabaseName,
+	}
+	q, args := sourceTableQuery.QueryPredicate(p)
+
+	var c SourceTableParams
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
+		return "", err
+	}
+
+	return c.TableId.String, nil
+}
+
+func ScanSourceTable(conn *sqlx.DB, id string) (SourceTableParams, error) {
+	q, args := sourceTableQuery.QueryPredicate(map[string]string{"mz_tables.id": id})
+
+	var c SourceTableParams
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
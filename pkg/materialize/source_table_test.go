@@ -0,0 +1,74 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+var sourceTable = MaterializeObject{Name: "table", SchemaName: "schema", DatabaseName: "database"}
+
+func TestSourceTablePostgresCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table" FROM SOURCE "materialize"."public"."source" \(REFERENCE "upstream_schema"."upstream_table"\) WITH \(TEXT COLUMNS \("column_1", "column_2"\), EXCLUDE COLUMNS \("column_3"\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceTableBuilder(db, sourceTable)
+		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "public", DatabaseName: "materialize"})
+		b.UpstreamName("upstream_table")
+		b.UpstreamSchemaName("upstream_schema")
+		b.TextColumns([]string{"column_1", "column_2"})
+		b.ExcludeColumns([]string{"column_3"})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceTableKafkaCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table" FROM SOURCE "materialize"."public"."source" \(REFERENCE "topic"\) KEY FORMAT TEXT VALUE FORMAT JSON INCLUDE KEY AS "message_key", PARTITION, TIMESTAMP AS "ts" ENVELOPE UPSERT;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceTableBuilder(db, sourceTable)
+		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "public", DatabaseName: "materialize"})
+		b.UpstreamName("topic")
+		b.KeyFormat(SourceFormatSpecStruct{Text: true})
+		b.ValueFormat(SourceFormatSpecStruct{Json: true})
+		b.Include(SourceTableIncludeStruct{Key: true, KeyAlias: "message_key", Partition: true, Timestamp: true, TimestampAlias: "ts"})
+		b.Envelope(KafkaSourceEnvelopeStruct{Upsert: true})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceTableRename(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER TABLE "database"."schema"."table" RENAME TO "new_table";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewSourceTableBuilder(db, sourceTable).Rename("new_table"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceTableDrop(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`DROP TABLE "database"."schema"."table";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewSourceTableBuilder(db, sourceTable).Drop(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
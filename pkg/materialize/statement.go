@@ -0,0 +1,193 @@
+package materialize
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+var simpleIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
+
+const operatorChars = "+-*/<>=~!@#%^&|`?:"
+
+// Splits a statement into words, identifiers, string literals and symbols.
+// Comments and whitespace are dropped, unquoted words are lowercased and
+// quotes are removed from identifiers that do not need them
+func tokenize(stmt string) []token {
+	var t []token
+	r := []rune(stmt)
+
+	for i := 0; i < len(r); {
+		c := r[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+
+		case c == '-' && i+1 < len(r) && r[i+1] == '-':
+			for i < len(r) && r[i] != '\n' {
+				i++
+			}
+
+		case c == '/' && i+1 < len(r) && r[i+1] == '*':
+			i += 2
+			for i < len(r) && !(r[i] == '*' && i+1 < len(r) && r[i+1] == '/') {
+				i++
+			}
+			i += 2
+
+		case c == '\'' || c == '"':
+			j := i + 1
+			var s strings.Builder
+			for j < len(r) {
+				if r[j] == c {
+					if j+1 < len(r) && r[j+1] == c {
+						s.WriteRune(c)
+						j += 2
+						continue
+					}
+					break
+				}
+				s.WriteRune(r[j])
+				j++
+			}
+			i = j + 1
+
+			if c == '\'' {
+				t = append(t, token{tokenString, QuoteString(s.String())})
+			} else if simpleIdentifier.MatchString(s.String()) {
+				t = append(t, token{tokenIdent, s.String()})
+			} else {
+				t = append(t, token{tokenIdent, QuoteIdentifier(s.String())})
+			}
+
+		case c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c):
+			j := i
+			for j < len(r) && (r[j] == '_' || r[j] == '$' || unicode.IsLetter(r[j]) || unicode.IsDigit(r[j])) {
+				j++
+			}
+			t = append(t, token{tokenWord, strings.ToLower(string(r[i:j]))})
+			i = j
+
+		case strings.ContainsRune(operatorChars, c):
+			j := i
+			for j < len(r) && strings.ContainsRune(operatorChars, r[j]) {
+				if r[j] == '-' && j+1 < len(r) && r[j+1] == '-' {
+					break
+				}
+				j++
+			}
+			t = append(t, token{tokenSymbol, string(r[i:j])})
+			i = j
+
+		default:
+			t = append(t, token{tokenSymbol, string(c)})
+			i++
+		}
+	}
+
+	return t
+}
+
+func isName(t token) bool {
+	return t.kind == tokenWord || t.kind == tokenIdent
+}
+
+// Returns the select statement of a `create_sql` definition, everything after
+// the first top level AS
+func CreateStatement(createSql string) string {
+	r := []rune(createSql)
+	depth := 0
+
+	for i := 0; i < len(r); i++ {
+		switch c := r[i]; {
+		case c == '\'' || c == '"':
+			for i++; i < len(r) && r[i] != c; i++ {
+			}
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+		case depth == 0 && (c == 'A' || c == 'a') && i+1 < len(r) && (r[i+1] == 'S' || r[i+1] == 's'):
+			before := i == 0 || unicode.IsSpace(r[i-1])
+			after := i+2 == len(r) || unicode.IsSpace(r[i+2])
+			if before && after {
+				return strings.TrimSuffix(strings.TrimSpace(string(r[i+2:])), ";")
+			}
+		}
+	}
+
+	return createSql
+}
+
+// The database and schemas of a session that unqualified names resolve to.
+// Materialize qualifies the names in `create_sql` with them
+type SearchPath struct {
+	DatabaseName string
+	SchemaNames  []string
+}
+
+// Returns a canonical form of the statement so that statements that only
+// differ in whitespace, comments, keyword case, identifier quoting or the
+// qualification of objects in the database and schemas of the search path
+// compare equal
+func NormalizeStatement(stmt string, p SearchPath) string {
+	t := tokenize(stmt)
+
+	for len(t) > 0 && t[len(t)-1].text == ";" {
+		t = t[:len(t)-1]
+	}
+
+	db := tokenize(QuoteIdentifier(p.DatabaseName))
+	sc := map[string]bool{}
+	for _, n := range p.SchemaNames {
+		if s := tokenize(QuoteIdentifier(n)); len(s) == 1 {
+			sc[s[0].text] = true
+		}
+	}
+
+	var o []string
+	for i := 0; i < len(t); i++ {
+		// Start of a dotted name
+		if isName(t[i]) && (i == 0 || t[i-1].text != ".") {
+			j := i
+			var parts []string
+			for j < len(t) && isName(t[j]) {
+				parts = append(parts, t[j].text)
+				if j+2 < len(t) && t[j+1].text == "." && isName(t[j+2]) {
+					j += 2
+					continue
+				}
+				break
+			}
+
+			if len(parts) >= 3 && p.DatabaseName != "" && len(db) == 1 && parts[0] == db[0].text {
+				parts = parts[1:]
+			}
+			if len(parts) == 2 && sc[parts[0]] {
+				parts = parts[1:]
+			}
+
+			o = append(o, strings.Join(parts, " . "))
+			i = j
+			continue
+		}
+
+		o = append(o, t[i].text)
+	}
+
+	return strings.Join(o, " ")
+}
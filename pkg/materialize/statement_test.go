@@ -0,0 +1,54 @@
+package materialize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStatement(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(`SELECT "a" FROM "materialize"."public"."t"`, CreateStatement(`CREATE VIEW "materialize"."public"."v" AS SELECT "a" FROM "materialize"."public"."t"`))
+	r.Equal(`SELECT 1 AS x`, CreateStatement(`CREATE MATERIALIZED VIEW "materialize"."public"."mv" IN CLUSTER [u1] WITH (ASSERT NOT NULL "a", REFRESH = ON COMMIT) AS SELECT 1 AS x;`))
+	r.Equal(`SELECT 1`, CreateStatement(`CREATE VIEW "materialize"."public"." as " AS SELECT 1`))
+}
+
+func TestNormalizeStatement(t *testing.T) {
+	r := require.New(t)
+
+	// Session of the provider while the objects live in schema "s"
+	p := SearchPath{DatabaseName: "materialize", SchemaNames: []string{"mz_catalog", "pg_catalog", "public"}}
+
+	cases := []struct {
+		a, b string
+	}{
+		{"SELECT 1", "select   1;"},
+		{"SELECT a\n  FROM t -- all rows", `SELECT "a" FROM "materialize"."public"."t"`},
+		{"SELECT t.a FROM t /* comment */", `SELECT "t"."a" FROM "materialize"."public"."t"`},
+		{"SELECT a FROM s.t", `SELECT "a" FROM "materialize"."s"."t"`},
+		{"SELECT a FROM other.t", `SELECT "a" FROM "materialize"."other"."t"`},
+		{"SELECT a FROM db.s.t", `SELECT "a" FROM "db"."s"."t"`},
+		{"SELECT a FROM public.t", "SELECT a FROM t"},
+		{"SELECT a FROM mz_tables", `SELECT "a" FROM "mz_catalog"."mz_tables"`},
+		{"SELECT a::text||'x' FROM t", `SELECT "a"::text || 'x' FROM t`},
+	}
+	for _, c := range cases {
+		r.Equal(NormalizeStatement(c.a, p), NormalizeStatement(c.b, p), c.a)
+	}
+
+	different := []struct {
+		a, b string
+	}{
+		{"SELECT 'A'", "SELECT 'a'"},
+		{`SELECT "A" FROM t`, `SELECT a FROM t`},
+		{"SELECT a FROM t", "SELECT b FROM t"},
+		{"SELECT a FROM db.s.t", "SELECT a FROM s.t"},
+		{"SELECT a FROM s.t", "SELECT a FROM t"},
+		{"SELECT a FROM db.public.t", "SELECT a FROM t"},
+		{"SELECT a FROM t WHERE a > 1", "SELECT a FROM t"},
+	}
+	for _, c := range different {
+		r.NotEqual(NormalizeStatement(c.a, p), NormalizeStatement(c.b, p), c.a)
+	}
+}
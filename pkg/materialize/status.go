@@ -0,0 +1,136 @@
+package materialize
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ObjectStatusParams struct {
+	ObjectId           sql.NullString `db:"id"`
+	ObjectName         sql.NullString `db:"name"`
+	Type               sql.NullString `db:"type"`
+	Status             sql.NullString `db:"status"`
+	Error              sql.NullString `db:"error"`
+	LastStatusChangeAt sql.NullString `db:"last_status_change_at"`
+}
+
+var sourceStatusQuery = NewBaseQuery(`
+	SELECT
+		mz_source_statuses.id,
+		mz_source_statuses.name,
+		mz_source_statuses.type,
+		mz_source_statuses.status,
+		mz_source_statuses.error,
+		mz_source_statuses.last_status_change_at
+	FROM mz_internal.mz_source_statuses`)
+
+func ScanSourceStatus(conn *sqlx.DB, id string) (ObjectStatusParams, error) {
+	p := map[string]string{
+		"mz_source_statuses.id": id,
+	}
+	q, args := sourceStatusQuery.QueryPredicate(p)
+
+	var c ObjectStatusParams
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+type SourceStatusListParams struct {
+	ObjectStatusParams
+	SchemaName   sql.NullString `db:"schema_name"`
+	DatabaseName sql.NullString `db:"database_name"`
+}
+
+var sourceStatusListQuery = NewBaseQuery(`
+	SELECT
+		mz_source_statuses.id,
+		mz_source_statuses.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_source_statuses.type,
+		mz_source_statuses.status,
+		mz_source_statuses.error,
+		mz_source_statuses.last_status_change_at
+	FROM mz_internal.mz_source_statuses
+	JOIN mz_sources
+		ON mz_source_statuses.id = mz_sources.id
+	JOIN mz_schemas
+		ON mz_sources.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id`)
+
+// Lists the status of the sources, optionally limited to a database, schema
+// or source name
+func ListSourceStatuses(conn *sqlx.DB, sourceName, schemaName, databaseName string) ([]SourceStatusListParams, error) {
+	p := map[string]string{
+		"mz_source_statuses.name": sourceName,
+		"mz_schemas.name":         schemaName,
+		"mz_databases.name":       databaseName,
+	}
+	q, args := sourceStatusListQuery.QueryPredicate(p)
+
+	var c []SourceStatusListParams
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+var sinkStatusQuery = NewBaseQuery(`
+	SELECT
+		mz_sink_statuses.id,
+		mz_sink_statuses.name,
+		mz_sink_statuses.type,
+		mz_sink_statuses.status,
+		mz_sink_statuses.error,
+		mz_sink_statuses.last_status_change_at
+	FROM mz_internal.mz_sink_statuses`)
+
+func ScanSinkStatus(conn *sqlx.DB, id string) (ObjectStatusParams, error) {
+	p := map[string]string{
+		"mz_sink_statuses.id": id,
+	}
+	q, args := sinkStatusQuery.QueryPredicate(p)
+
+	var c ObjectStatusParams
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+type ClusterReplicaStatusParams struct {
+	ReplicaId sql.NullString `db:"replica_id"`
+	ProcessId sql.NullString `db:"process_id"`
+	Status    sql.NullString `db:"status"`
+	Reason    sql.NullString `db:"reason"`
+}
+
+var clusterReplicaStatusQuery = NewBaseQuery(`
+	SELECT
+		mz_cluster_replica_statuses.replica_id,
+		mz_cluster_replica_statuses.process_id,
+		mz_cluster_replica_statuses.status,
+		mz_cluster_replica_statuses.reason
+	FROM mz_internal.mz_cluster_replica_statuses`)
+
+// Lists the status of each process of the replica
+func ListClusterReplicaStatuses(conn *sqlx.DB, id string) ([]ClusterReplicaStatusParams, error) {
+	p := map[string]string{
+		"mz_cluster_replica_statuses.replica_id": id,
+	}
+	q, args := clusterReplicaStatusQuery.QueryPredicate(p)
+
+	var c []ClusterReplicaStatusParams
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
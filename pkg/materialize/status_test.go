@@ -0,0 +1,73 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestScanSourceStatus(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_source_statuses.id = 'u1'`
+		testhelpers.MockSourceStatusScan(mock, p, "stalled", "kafka: broker unavailable")
+
+		s, err := ScanSourceStatus(db, "u1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s.Status.String != "stalled" || s.Error.String != "kafka: broker unavailable" {
+			t.Fatalf("unexpected source status: %v", s)
+		}
+	})
+}
+
+func TestListSourceStatuses(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockSourceStatusListScan(mock, p, "stalled", "kafka: broker unavailable")
+
+		s, err := ListSourceStatuses(db, "", "schema", "database")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(s) != 1 || s[0].SchemaName.String != "schema" || s[0].Status.String != "stalled" {
+			t.Fatalf("unexpected source statuses: %v", s)
+		}
+	})
+}
+
+func TestScanSinkStatus(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_sink_statuses.id = 'u1'`
+		testhelpers.MockSinkStatusScan(mock, p, "running", "")
+
+		s, err := ScanSinkStatus(db, "u1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s.Status.String != "running" {
+			t.Fatalf("unexpected sink status: %v", s)
+		}
+	})
+}
+
+func TestListClusterReplicaStatuses(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_cluster_replica_statuses.replica_id = 'u1'`
+		testhelpers.MockClusterReplicaStatusScan(mock, p, "not-ready", "oom-killed")
+
+		s, err := ListClusterReplicaStatuses(db, "u1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(s) != 1 || s[0].Status.String != "not-ready" || s[0].Reason.String != "oom-killed" {
+			t.Fatalf("unexpected replica statuses: %v", s)
+		}
+	})
+}
@@ -12,6 +12,7 @@ type TableColumn struct {
 	ColName string
 	ColType string
 	NotNull bool
+	Default string
 	Comment string
 }
 
@@ -23,6 +24,7 @@ func GetTableColumnStruct(v []interface{}) []TableColumn {
 			ColName: c["name"].(string),
 			ColType: c["type"].(string),
 			NotNull: c["nullable"].(bool),
+			Default: c["default"].(string),
 			Comment: c["comment"].(string),
 		})
 	}
@@ -55,21 +57,26 @@ func (b *TableBuilder) Column(c []TableColumn) *TableBuilder {
 	return b
 }
 
+func (c TableColumn) definition() string {
+	s := strings.Builder{}
+
+	s.WriteString(fmt.Sprintf(`%s %s`, c.ColName, c.ColType))
+	if c.NotNull {
+		s.WriteString(` NOT NULL`)
+	}
+	if c.Default != "" {
+		s.WriteString(fmt.Sprintf(` DEFAULT %s`, c.Default))
+	}
+	return s.String()
+}
+
 func (b *TableBuilder) Create() error {
 	q := strings.Builder{}
 	q.WriteString(fmt.Sprintf(`CREATE TABLE %s`, b.QualifiedName()))
 
 	var column []string
 	for _, c := range b.column {
-		s := strings.Builder{}
-
-		s.WriteString(fmt.Sprintf(`%s %s`, c.ColName, c.ColType))
-		if c.NotNull {
-			s.WriteString(` NOT NULL`)
-		}
-		o := s.String()
-		column = append(column, o)
-
+		column = append(column, c.definition())
 	}
 	p := strings.Join(column[:], ", ")
 	q.WriteString(fmt.Sprintf(` (%s);`, p))
@@ -77,6 +84,13 @@ func (b *TableBuilder) Create() error {
 	return b.ddl.exec(q.String())
 }
 
+// Appends the column to the table. Materialize only supports adding nullable
+// columns without a default
+func (b *TableBuilder) AddColumn(c TableColumn) error {
+	q := fmt.Sprintf(`ALTER TABLE %s ADD COLUMN %s;`, b.QualifiedName(), c.definition())
+	return b.ddl.exec(q)
+}
+
 func (b *TableBuilder) Rename(newName string) error {
 	n := QualifiedName(newName)
 	return b.ddl.rename(b.QualifiedName(), n)
@@ -95,6 +109,7 @@ type TableParams struct {
 	Comment      sql.NullString `db:"comment"`
 	OwnerName    sql.NullString `db:"owner_name"`
 	Privileges   sql.NullString `db:"privileges"`
+	SourceId     sql.NullString `db:"source_id"`
 }
 
 var tableQuery = NewBaseQuery(`
@@ -105,7 +120,8 @@ var tableQuery = NewBaseQuery(`
 		mz_databases.name AS database_name,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_tables.privileges
+		mz_tables.privileges,
+		mz_tables.source_id
 	FROM mz_tables
 	JOIN mz_schemas
 		ON mz_tables.schema_id = mz_schemas.id
@@ -127,10 +143,10 @@ func TableId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 		"mz_schemas.name":   obj.SchemaName,
 		"mz_databases.name": obj.DatabaseName,
 	}
-	q := tableQuery.QueryPredicate(p)
+	q, args := tableQuery.QueryPredicate(p)
 
 	var c TableParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -141,25 +157,25 @@ func ScanTable(conn *sqlx.DB, id string) (TableParams, error) {
 	p := map[string]string{
 		"mz_tables.id": id,
 	}
-	q := tableQuery.QueryPredicate(p)
+	q, args := tableQuery.QueryPredicate(p)
 
 	var c TableParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListTables(conn *sqlx.DB, schemaName, databaseName string) ([]TableParams, error) {
+func ListTables(conn *sqlx.DB, schemaName, databaseName string, filters ...Predicate) ([]TableParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
 	}
-	q := tableQuery.QueryPredicate(p)
+	q, args := tableQuery.Query(append(EqualPredicates(p), filters...)...)
 
 	var c []TableParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
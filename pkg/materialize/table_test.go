@@ -34,6 +34,47 @@ func TestTableCreate(t *testing.T) {
 	})
 }
 
+func TestTableCreateDefault(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table" \(column_1 int DEFAULT 1, column_2 timestamp NOT NULL DEFAULT now\(\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "table", SchemaName: "schema", DatabaseName: "database"}
+		b := NewTableBuilder(db, o)
+		b.Column([]TableColumn{
+			{
+				ColName: "column_1",
+				ColType: "int",
+				Default: "1",
+			},
+			{
+				ColName: "column_2",
+				ColType: "timestamp",
+				NotNull: true,
+				Default: "now()",
+			},
+		})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestTableAddColumn(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER TABLE "database"."schema"."table" ADD COLUMN column_3 text;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "table", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewTableBuilder(db, o).AddColumn(TableColumn{ColName: "column_3", ColType: "text"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestTableRename(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(
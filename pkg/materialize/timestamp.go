@@ -0,0 +1,58 @@
+package materialize
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// Parses a timestamp literal or a number of milliseconds since the Unix epoch
+// as used by `mz_timestamp`. Timestamps without a time zone are in UTC
+func ParseTimestamp(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.UnixMilli(ms).UTC(), nil
+	}
+
+	for _, l := range timestampLayouts {
+		if t, err := time.Parse(l, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", s)
+}
+
+func FormatTimestamp(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04:05.999-07:00")
+}
+
+// Whether both timestamps refer to the same instant
+func EquivalentTimestamps(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	ta, err := ParseTimestamp(a)
+	if err != nil {
+		return false
+	}
+
+	tb, err := ParseTimestamp(b)
+	if err != nil {
+		return false
+	}
+
+	return ta.Equal(tb)
+}
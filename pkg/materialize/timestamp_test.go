@@ -0,0 +1,31 @@
+package materialize
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	r := require.New(t)
+
+	e := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, s := range []string{"1704067200000", "2024-01-01", "2024-01-01 00:00:00", "2024-01-01T00:00:00Z", "2024-01-01 01:00:00+01"} {
+		ts, err := ParseTimestamp(s)
+		r.NoError(err, s)
+		r.True(e.Equal(ts), s)
+	}
+
+	_, err := ParseTimestamp("tomorrow")
+	r.Error(err)
+}
+
+func TestFormatTimestamp(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("2024-01-01 00:00:00+00:00", FormatTimestamp(time.UnixMilli(1704067200000)))
+	r.Equal("2024-01-01 00:00:00.5+00:00", FormatTimestamp(time.UnixMilli(1704067200500)))
+	r.True(EquivalentTimestamps("2024-01-01 00:00:00+00:00", "1704067200000"))
+	r.False(EquivalentTimestamps("2024-01-02", "1704067200000"))
+}
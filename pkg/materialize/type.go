@@ -115,6 +115,9 @@ type TypeParams struct {
 	Comment      sql.NullString `db:"comment"`
 	OwnerName    sql.NullString `db:"owner_name"`
 	Privileges   sql.NullString `db:"privileges"`
+	ElementType  sql.NullString `db:"element_type"`
+	KeyType      sql.NullString `db:"key_type"`
+	ValueType    sql.NullString `db:"value_type"`
 }
 
 var typeQuery = NewBaseQuery(`
@@ -126,7 +129,10 @@ var typeQuery = NewBaseQuery(`
 		mz_types.category,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_types.privileges
+		mz_types.privileges,
+		element_types.name AS element_type,
+		key_types.name AS key_type,
+		value_types.name AS value_type
 	FROM mz_types
 	JOIN mz_schemas
 		ON mz_types.schema_id = mz_schemas.id
@@ -139,7 +145,17 @@ var typeQuery = NewBaseQuery(`
 		FROM mz_internal.mz_comments
 		WHERE object_type = 'type'
 	) comments
-		ON mz_types.id = comments.id`)
+		ON mz_types.id = comments.id
+	LEFT JOIN mz_list_types
+		ON mz_types.id = mz_list_types.id
+	LEFT JOIN mz_types AS element_types
+		ON mz_list_types.element_id = element_types.id
+	LEFT JOIN mz_map_types
+		ON mz_types.id = mz_map_types.id
+	LEFT JOIN mz_types AS key_types
+		ON mz_map_types.key_id = key_types.id
+	LEFT JOIN mz_types AS value_types
+		ON mz_map_types.value_id = value_types.id`)
 
 func TypeId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
@@ -147,10 +163,10 @@ func TypeId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 		"mz_schemas.name":   obj.SchemaName,
 		"mz_databases.name": obj.DatabaseName,
 	}
-	q := typeQuery.QueryPredicate(p)
+	q, args := typeQuery.QueryPredicate(p)
 
 	var c TypeParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -161,25 +177,25 @@ func ScanType(conn *sqlx.DB, id string) (TypeParams, error) {
 	p := map[string]string{
 		"mz_types.id": id,
 	}
-	q := typeQuery.QueryPredicate(p)
+	q, args := typeQuery.QueryPredicate(p)
 
 	var c TypeParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListTypes(conn *sqlx.DB, schemaName, databaseName string) ([]TypeParams, error) {
+func ListTypes(conn *sqlx.DB, schemaName, databaseName string, filters ...Predicate) ([]TypeParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
 	}
-	q := typeQuery.QueryPredicate(p)
+	q, args := typeQuery.Query(append(EqualPredicates(p), filters...)...)
 
 	var c []TypeParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
@@ -0,0 +1,107 @@
+package materialize
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx"
+)
+
+// Methods used to validate a select statement before it is applied
+const (
+	ValidateExplain     = "explain"
+	ValidateTransaction = "transaction"
+	ValidateNone        = "none"
+)
+
+var ValidationMethods = []string{ValidateExplain, ValidateTransaction, ValidateNone}
+
+// SQLSTATE codes of statements that reference objects that do not exist yet
+var undefinedObjectCodes = map[string]bool{
+	"42P01": true, // undefined_table
+	"3F000": true, // invalid_schema_name
+	"3D000": true, // invalid_catalog_name
+	"42704": true, // undefined_object
+}
+
+// Materialize reports some planning errors without a specific SQLSTATE
+var undefinedObjectMessage = regexp.MustCompile(`unknown (catalog item|schema|database|cluster) '`)
+
+// Reports whether the statement failed because it references an object that
+// does not exist, such as one created later in the same apply
+func UndefinedObject(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var s sqlState
+	if errors.As(err, &s) && undefinedObjectCodes[s.SQLState()] {
+		return true
+	}
+	return undefinedObjectMessage.MatchString(err.Error())
+}
+
+// Runs the statement in a transaction that is always rolled back
+func (b *Builder) dryRun(statement string) error {
+	tx, err := b.conn.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(statement); err != nil {
+		log.Printf("[DEBUG] error executing: %s", statement)
+		return err
+	}
+
+	return nil
+}
+
+// Validates a statement by running it with the prefix and reports errors with
+// the position relative to the statement
+func (b *Builder) validate(prefix, statement string, dryRun bool) error {
+	q := prefix + statement + ";"
+
+	var err error
+	if dryRun {
+		err = b.dryRun(q)
+	} else {
+		err = b.exec(q)
+	}
+
+	return statementError(prefix, statement, err)
+}
+
+func statementError(prefix, statement string, err error) error {
+	var pgErr pgx.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	// Positions are one based and counted in characters of the full query
+	r := []rune(statement)
+	p := int(pgErr.Position) - len([]rune(prefix))
+	if pgErr.Position == 0 || p < 1 || p > len(r) {
+		return err
+	}
+
+	line := strings.Count(string(r[:p-1]), "\n") + 1
+	start := p - 1
+	for start > 0 && r[start-1] != '\n' {
+		start--
+	}
+	column := p - start
+
+	end := p - 1
+	for end < len(r) && r[end] != '\n' {
+		end++
+	}
+
+	return fmt.Errorf(
+		"%s (SQLSTATE %s) at line %d, column %d:\n%s\n%s^",
+		pgErr.Message, pgErr.Code, line, column, string(r[start:end]), strings.Repeat(" ", column-1),
+	)
+}
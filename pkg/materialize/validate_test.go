@@ -0,0 +1,91 @@
+package materialize
+
+import (
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jackc/pgx"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewValidateExplain(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`EXPLAIN SELECT 1 FROM t1;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewViewBuilder(db, o).SelectStmt("SELECT 1 FROM t1")
+
+		if err := b.Validate(ValidateExplain); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestViewValidateTransaction(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(
+			`CREATE OR REPLACE VIEW "database"."schema"."view" AS SELECT 1 FROM t1;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectRollback()
+
+		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewViewBuilder(db, o).SelectStmt("SELECT 1 FROM t1")
+
+		if err := b.Validate(ValidateTransaction); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestViewValidateNone(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewViewBuilder(db, o).SelectStmt("SELECT 1 FROM t1")
+
+		if err := b.Validate(ValidateNone); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestMaterializedViewValidateTransaction(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(
+			`CREATE OR REPLACE MATERIALIZED VIEW "database"."schema"."materialized_view" IN CLUSTER "cluster" AS SELECT 1 FROM t1;`,
+		).WillReturnError(pgx.PgError{Code: "42601", Message: "Expected end of statement, found identifier \"t2\"", Position: 113})
+		mock.ExpectRollback()
+
+		o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewMaterializedViewBuilder(db, o).ClusterName("cluster").SelectStmt("SELECT 1 FROM t1")
+
+		err := b.Validate(ValidateTransaction)
+		require.EqualError(t, err, "Expected end of statement, found identifier \"t2\" (SQLSTATE 42601) at line 1, column 13:\nSELECT 1 FROM t1\n            ^")
+	})
+}
+
+func TestStatementError(t *testing.T) {
+	r := require.New(t)
+	statement := "SELECT a,\n  b FORM t1"
+	err := pgx.PgError{Code: "42601", Message: "Expected end of statement, found FORM", Position: 23}
+
+	e := statementError("EXPLAIN ", statement, err)
+	r.EqualError(e, "Expected end of statement, found FORM (SQLSTATE 42601) at line 2, column 5:\n  b FORM t1\n    ^")
+
+	// Errors without a position are returned as is
+	err.Position = 0
+	r.Equal(err, statementError("EXPLAIN ", statement, err))
+}
+
+func TestUndefinedObject(t *testing.T) {
+	r := require.New(t)
+	r.True(UndefinedObject(pgx.PgError{Code: "42P01", Message: "unknown catalog item 't1'"}))
+	r.True(UndefinedObject(pgx.PgError{Code: "XX000", Message: "unknown schema 'deploy'"}))
+	r.False(UndefinedObject(pgx.PgError{Code: "42601", Message: "Expected end of statement"}))
+	r.False(UndefinedObject(errors.New("connection refused")))
+	r.False(UndefinedObject(nil))
+}
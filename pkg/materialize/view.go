@@ -39,6 +39,26 @@ func (b *ViewBuilder) Create() error {
 	return b.ddl.exec(q)
 }
 
+// Checks the select statement without changing the view, either by explaining
+// it or by replacing the view in a transaction that is rolled back
+func (b *ViewBuilder) Validate(method string) error {
+	switch method {
+	case ValidateNone:
+		return nil
+	case ValidateTransaction:
+		return b.ddl.validate(fmt.Sprintf(`CREATE OR REPLACE VIEW %s AS `, b.QualifiedName()), b.selectStmt, true)
+	default:
+		return b.ddl.validate(`EXPLAIN `, b.selectStmt, false)
+	}
+}
+
+// Replaces the definition of an existing view. This will fail if any objects
+// depend on the view
+func (b *ViewBuilder) Replace() error {
+	q := fmt.Sprintf(`CREATE OR REPLACE VIEW %s AS %s;`, b.QualifiedName(), b.selectStmt)
+	return b.ddl.exec(q)
+}
+
 func (b *ViewBuilder) Rename(newName string) error {
 	n := QualifiedName(newName)
 	return b.ddl.rename(b.QualifiedName(), n)
@@ -58,6 +78,7 @@ type ViewParams struct {
 	Comment      sql.NullString `db:"comment"`
 	OwnerName    sql.NullString `db:"owner_name"`
 	Privileges   sql.NullString `db:"privileges"`
+	CreateSql    sql.NullString `db:"create_sql"`
 }
 
 var viewQuery = NewBaseQuery(`
@@ -68,7 +89,8 @@ var viewQuery = NewBaseQuery(`
 		mz_databases.name AS database_name,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_views.privileges
+		mz_views.privileges,
+		mz_views.create_sql
 	FROM mz_views
 	JOIN mz_schemas
 		ON mz_views.schema_id = mz_schemas.id
@@ -89,10 +111,10 @@ func ViewId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 		"mz_schemas.name":   obj.SchemaName,
 		"mz_databases.name": obj.DatabaseName,
 	}
-	q := viewQuery.QueryPredicate(p)
+	q, args := viewQuery.QueryPredicate(p)
 
 	var c ViewParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return "", err
 	}
 
@@ -103,25 +125,25 @@ func ScanView(conn *sqlx.DB, id string) (ViewParams, error) {
 	p := map[string]string{
 		"mz_views.id": id,
 	}
-	q := viewQuery.QueryPredicate(p)
+	q, args := viewQuery.QueryPredicate(p)
 
 	var c ViewParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := getWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListViews(conn *sqlx.DB, schemaName, databaseName string) ([]ViewParams, error) {
+func ListViews(conn *sqlx.DB, schemaName, databaseName string, filters ...Predicate) ([]ViewParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
 	}
-	q := viewQuery.QueryPredicate(p)
+	q, args := viewQuery.Query(append(EqualPredicates(p), filters...)...)
 
 	var c []ViewParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := selectWithRetry(conn, &c, q, args...); err != nil {
 		return c, err
 	}
 
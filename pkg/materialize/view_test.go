@@ -24,6 +24,22 @@ func TestViewCreate(t *testing.T) {
 	})
 }
 
+func TestViewReplace(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE OR REPLACE VIEW "database"."schema"."view" AS SELECT 2 FROM t1;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewViewBuilder(db, o)
+		b.SelectStmt("SELECT 2 FROM t1")
+
+		if err := b.Replace(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestViewRename(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(
@@ -37,7 +37,7 @@ func TestAccClusterReplica_basic(t *testing.T) {
 				ResourceName:            "materialize_cluster_replica.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"introspection_debugging", "introspection_interval"},
+				ImportStateVerifyIgnore: []string{"introspection_debugging", "introspection_interval", "wait_for_ready"},
 			},
 		},
 	})
@@ -96,6 +96,25 @@ func TestAccClusterReplica_disappears(t *testing.T) {
 	})
 }
 
+func TestAccClusterReplica_waitForReady(t *testing.T) {
+	clusterName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	replicaName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllClusterReplicaDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterReplicaWaitForReady(clusterName, replicaName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterReplicaExists("materialize_cluster_replica.test"),
+					resource.TestCheckResourceAttr("materialize_cluster_replica.test", "wait_for_ready", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccClusterReplicaResource(clusterName, clusterReplica string) string {
 	return fmt.Sprintf(`
 resource "materialize_cluster" "test" {
@@ -127,6 +146,25 @@ resource "materialize_cluster_replica" "test" {
 `, clusterName, clusterReplica, comment)
 }
 
+func testAccClusterReplicaWaitForReady(clusterName, clusterReplica string) string {
+	return fmt.Sprintf(`
+resource "materialize_cluster" "test" {
+	name = "%[1]s"
+}
+
+resource "materialize_cluster_replica" "test" {
+	cluster_name = materialize_cluster.test.name
+	name = "%[2]s"
+	size = "3xsmall"
+	wait_for_ready = true
+
+	timeouts {
+		create = "5m"
+	}
+}
+`, clusterName, clusterReplica)
+}
+
 func testAccCheckClusterReplicaExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
@@ -85,6 +85,55 @@ func TestAccCluster_update(t *testing.T) {
 	})
 }
 
+func TestAccCluster_scheduling(t *testing.T) {
+	clusterName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSchedulingResource(clusterName, "3xsmall"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("materialize_cluster.test"),
+					resource.TestCheckResourceAttr("materialize_cluster.test", "scheduling.0.on_refresh", "true"),
+					resource.TestCheckResourceAttr("materialize_cluster.test", "scheduling.0.hydration_time_estimate", "1 hour"),
+					resource.TestCheckResourceAttr("materialize_cluster.test", "replication_factor", "0"),
+				),
+			},
+			{
+				Config: testAccClusterSchedulingResource(clusterName, "2xsmall"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("materialize_cluster.test"),
+					resource.TestCheckResourceAttr("materialize_cluster.test", "size", "2xsmall"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCluster_waitUntilReady(t *testing.T) {
+	clusterName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterWaitUntilReadyResource(clusterName, "3xsmall"),
+			},
+			{
+				Config: testAccClusterWaitUntilReadyResource(clusterName, "2xsmall"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("materialize_cluster.test"),
+					resource.TestCheckResourceAttr("materialize_cluster.test", "size", "2xsmall"),
+					resource.TestCheckResourceAttr("materialize_cluster.test", "replication_factor", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCluster_disappears(t *testing.T) {
 	clusterName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	cluster2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -168,3 +217,33 @@ func testAccCheckAllClusterDestroyed(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccClusterSchedulingResource(clusterName, clusterSize string) string {
+	return fmt.Sprintf(`
+resource "materialize_cluster" "test" {
+	name = "%[1]s"
+	size = "%[2]s"
+
+	scheduling {
+		on_refresh              = true
+		hydration_time_estimate = "1 hour"
+	}
+}
+`, clusterName, clusterSize)
+}
+
+func testAccClusterWaitUntilReadyResource(clusterName, clusterSize string) string {
+	return fmt.Sprintf(`
+resource "materialize_cluster" "test" {
+	name               = "%[1]s"
+	size               = "%[2]s"
+	replication_factor = 1
+
+	wait_until_ready {
+		enabled    = true
+		timeout    = "10 minutes"
+		on_timeout = "COMMIT"
+	}
+}
+`, clusterName, clusterSize)
+}
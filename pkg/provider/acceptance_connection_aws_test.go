@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccConnAws_basic(t *testing.T) {
+	connectionName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnAwsResource(connectionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnAwsExists("materialize_connection_aws.test"),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "name", connectionName),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "region", "us-east-1"),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "assume_role_arn", "arn:aws:iam::000000000000:role/materialize"),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "database_name", "materialize"),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "qualified_sql_name", fmt.Sprintf(`"materialize"."public"."%s"`, connectionName)),
+					resource.TestCheckResourceAttrSet("materialize_connection_aws.test", "principal"),
+					resource.TestCheckResourceAttrSet("materialize_connection_aws.test", "external_id"),
+				),
+			},
+			{
+				ResourceName:      "materialize_connection_aws.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+		},
+	})
+}
+
+func TestAccConnAws_disappears(t *testing.T) {
+	connectionName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllConnAwsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnAwsResource(connectionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnAwsExists("materialize_connection_aws.test"),
+					testAccCheckObjectDisappears(
+						materialize.MaterializeObject{
+							ObjectType: "CONNECTION",
+							Name:       connectionName,
+						},
+					),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConnAwsResource(connectionName string) string {
+	return fmt.Sprintf(`
+resource "materialize_connection_aws" "test" {
+	name            = "%[1]s"
+	schema_name     = "public"
+	region          = "us-east-1"
+	assume_role_arn = "arn:aws:iam::000000000000:role/materialize"
+}
+`, connectionName)
+}
+
+func testAccCheckConnAwsExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("connection aws not found: %s", name)
+		}
+		_, err := materialize.ScanConnectionAws(db, r.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckAllConnAwsDestroyed(s *terraform.State) error {
+	db := testAccProvider.Meta().(*sqlx.DB)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "materialize_connection_aws" {
+			continue
+		}
+
+		_, err := materialize.ScanConnectionAws(db, r.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("connection %v still exists", r.Primary.ID)
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+	}
+
+	return nil
+}
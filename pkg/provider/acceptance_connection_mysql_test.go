@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccConnMysql_basic(t *testing.T) {
+	secretName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	connectionName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	connection2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnMysqlResource(roleName, secretName, connectionName, connection2Name, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnMysqlExists("materialize_connection_mysql.test"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "name", connectionName),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "user.#", "1"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "user.0.text", "repluser"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "password.#", "1"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "password.0.name", secretName),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "password.0.database_name", "materialize"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "password.0.schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "database_name", "materialize"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "qualified_sql_name", fmt.Sprintf(`"materialize"."public"."%s"`, connectionName)),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "ownership_role", "mz_system"),
+					testAccCheckConnMysqlExists("materialize_connection_mysql.test_role"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test_role", "name", connection2Name),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test_role", "ownership_role", roleName),
+				),
+			},
+			{
+				ResourceName:      "materialize_connection_mysql.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+		},
+	})
+}
+
+func TestAccConnMysql_update(t *testing.T) {
+	secretName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	slug := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	connectionName := fmt.Sprintf("old_%s", slug)
+	newConnectionName := fmt.Sprintf("new_%s", slug)
+	connection2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnMysqlResource(roleName, secretName, connectionName, connection2Name, "mz_system"),
+			},
+			{
+				Config: testAccConnMysqlResource(roleName, secretName, newConnectionName, connection2Name, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnMysqlExists("materialize_connection_mysql.test"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "name", newConnectionName),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "database_name", "materialize"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test", "qualified_sql_name", fmt.Sprintf(`"materialize"."public"."%s"`, newConnectionName)),
+					testAccCheckConnMysqlExists("materialize_connection_mysql.test_role"),
+					resource.TestCheckResourceAttr("materialize_connection_mysql.test_role", "ownership_role", roleName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnMysql_disappears(t *testing.T) {
+	secretName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	connectionName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	connection2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllConnMysqlDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnMysqlResource(roleName, secretName, connectionName, connection2Name, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnMysqlExists("materialize_connection_mysql.test"),
+					testAccCheckObjectDisappears(
+						materialize.MaterializeObject{
+							ObjectType: "CONNECTION",
+							Name:       connectionName,
+						},
+					),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConnMysqlResource(roleName, secretName, connectionName, connection2Name, connectionOwner string) string {
+	return fmt.Sprintf(`
+resource "materialize_role" "test" {
+	name = "%[1]s"
+}
+
+resource "materialize_secret" "mysql_password" {
+	name          = "%[2]s"
+	value         = "c2VjcmV0Cg=="
+}
+
+resource "materialize_connection_mysql" "test" {
+	name = "%[3]s"
+	host = "mysql"
+	port = 3306
+	user {
+		text = "repluser"
+	}
+	password {
+		name          = materialize_secret.mysql_password.name
+		schema_name   = materialize_secret.mysql_password.schema_name
+		database_name = materialize_secret.mysql_password.database_name
+	}
+}
+
+resource "materialize_connection_mysql" "test_role" {
+	name = "%[4]s"
+	host = "mysql"
+	port = 3306
+	user {
+		text = "repluser"
+	}
+	password {
+		name          = materialize_secret.mysql_password.name
+		schema_name   = materialize_secret.mysql_password.schema_name
+		database_name = materialize_secret.mysql_password.database_name
+	}
+	ownership_role = "%[5]s"
+
+	depends_on = [materialize_role.test]
+}
+`, roleName, secretName, connectionName, connection2Name, connectionOwner)
+}
+
+func testAccCheckConnMysqlExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("connection mysql not found: %s", name)
+		}
+		_, err := materialize.ScanConnection(db, r.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckAllConnMysqlDestroyed(s *terraform.State) error {
+	db := testAccProvider.Meta().(*sqlx.DB)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "materialize_connection_mysql" {
+			continue
+		}
+
+		_, err := materialize.ScanConnection(db, r.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("connection %v still exists", r.Primary.ID)
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jmoiron/sqlx"
 )
@@ -110,6 +111,63 @@ func TestAccConnPostgres_disappears(t *testing.T) {
 	})
 }
 
+func TestAccConnPostgres_alter(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllConnPostgresDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnPostgresAlterResource(nameSpace, "postgres_password"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnPostgresExists("materialize_connection_postgres.test"),
+					resource.TestCheckResourceAttr("materialize_connection_postgres.test", "password.0.name", nameSpace+"_postgres_password"),
+				),
+			},
+			{
+				Config: testAccConnPostgresAlterResource(nameSpace, "rotated_password"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("materialize_connection_postgres.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnPostgresExists("materialize_connection_postgres.test"),
+					resource.TestCheckResourceAttr("materialize_connection_postgres.test", "password.0.name", nameSpace+"_rotated_password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConnPostgresAlterResource(nameSpace, passwordSecret string) string {
+	return fmt.Sprintf(`
+resource "materialize_secret" "postgres_password" {
+	name  = "%[1]s_postgres_password"
+	value = "c2VjcmV0Cg=="
+}
+
+resource "materialize_secret" "rotated_password" {
+	name  = "%[1]s_rotated_password"
+	value = "c2VjcmV0Cg=="
+}
+
+resource "materialize_connection_postgres" "test" {
+	name = "%[1]s_connection"
+	host = "postgres"
+	port = 5432
+	user {
+		text = "postgres"
+	}
+	password {
+		name = materialize_secret.%[2]s.name
+	}
+	database = "postgres"
+}
+`, nameSpace, passwordSecret)
+}
+
 func testAccConnPostgresResource(roleName, secretName, connectionName, connection2Name, connectionOwner string) string {
 	return fmt.Sprintf(`
 resource "materialize_role" "test" {
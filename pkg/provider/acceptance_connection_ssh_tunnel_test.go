@@ -103,6 +103,49 @@ func TestAccConnSshTunnel_disappears(t *testing.T) {
 	})
 }
 
+func TestAccConnSshTunnel_rotateKeys(t *testing.T) {
+	connectionName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	var primaryKey string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllConnSshTunnelDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnSshTunnelRotateKeysResource(connectionName, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnSshTunnelExists("materialize_connection_ssh_tunnel.test"),
+					resource.TestCheckResourceAttrSet("materialize_connection_ssh_tunnel.test", "public_key_1"),
+					resource.TestCheckResourceAttrSet("materialize_connection_ssh_tunnel.test", "public_key_2"),
+					resource.TestCheckResourceAttr("materialize_connection_ssh_tunnel.test", "rotate_keys_trigger", "initial"),
+					resource.TestCheckResourceAttrWith("materialize_connection_ssh_tunnel.test", "public_key_1", func(v string) error {
+						primaryKey = v
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccConnSshTunnelRotateKeysResource(connectionName, "rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_connection_ssh_tunnel.test", "rotate_keys_trigger", "rotated"),
+					resource.TestCheckResourceAttrWith("materialize_connection_ssh_tunnel.test", "public_key_1", func(v string) error {
+						if v == primaryKey {
+							return fmt.Errorf("public_key_1 was not rotated")
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("materialize_connection_ssh_tunnel.test", "public_key_2", func(v string) error {
+						if v != primaryKey {
+							return fmt.Errorf("public_key_2 %s does not match the previous primary key %s", v, primaryKey)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccConnSshTunnelResource(roleName, connectionName, connection2Name, connectionOwner string) string {
 	return fmt.Sprintf(`
 resource "materialize_role" "test" {
@@ -130,6 +173,19 @@ resource "materialize_connection_ssh_tunnel" "test_role" {
 `, roleName, connectionName, connection2Name, connectionOwner)
 }
 
+func testAccConnSshTunnelRotateKeysResource(connectionName, trigger string) string {
+	return fmt.Sprintf(`
+resource "materialize_connection_ssh_tunnel" "test" {
+	name                = "%[1]s"
+	schema_name         = "public"
+	host                = "ssh_host"
+	user                = "ssh_user"
+	port                = 22
+	rotate_keys_trigger = "%[2]s"
+}
+`, connectionName, trigger)
+}
+
 func testAccCheckConnSshTunnelExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
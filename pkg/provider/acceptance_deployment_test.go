@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccDeployment_basic(t *testing.T) {
+	slug := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDeploymentDestroyed(slug),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentResource(slug, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_deployment.test", "schema_name", slug),
+					resource.TestCheckResourceAttr("materialize_deployment.test", "cluster_name", slug),
+					resource.TestCheckResourceAttr("materialize_deployment.test", "shadow_schema_name", fmt.Sprintf("%s_deploy", slug)),
+					resource.TestCheckResourceAttr("materialize_deployment.test", "shadow_cluster_name", fmt.Sprintf("%s_deploy", slug)),
+					resource.TestCheckResourceAttr("materialize_deployment.test", "promote", "false"),
+				),
+			},
+			{
+				Config: testAccDeploymentResource(slug, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_deployment.test", "promote", "true"),
+					testAccCheckDeploymentPromoted(slug),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentResource(slug string, promote bool) string {
+	return fmt.Sprintf(`
+resource "materialize_cluster" "test" {
+	name = "%[1]s"
+	size = "3xsmall"
+}
+
+resource "materialize_schema" "test" {
+	name = "%[1]s"
+}
+
+resource "materialize_deployment" "test" {
+	schema_name  = materialize_schema.test.name
+	cluster_name = materialize_cluster.test.name
+	promote      = %[2]t
+}
+
+resource "materialize_materialized_view" "test" {
+	name         = "%[1]s_mv"
+	schema_name  = materialize_deployment.test.shadow_schema_name
+	cluster_name = materialize_deployment.test.shadow_cluster_name
+	statement    = "SELECT 1 AS id"
+
+	lifecycle {
+		ignore_changes = [schema_name, cluster_name]
+	}
+}
+`, slug, promote)
+}
+
+func testAccCheckDeploymentPromoted(slug string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		o := materialize.MaterializeObject{Name: fmt.Sprintf("%s_mv", slug), SchemaName: slug, DatabaseName: "materialize"}
+		if _, err := materialize.MaterializedViewId(db, o); err != nil {
+			return fmt.Errorf("materialized view not found in production schema %s after promotion: %s", slug, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDeploymentDestroyed(slug string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		o := materialize.MaterializeObject{Name: fmt.Sprintf("%s_deploy", slug), DatabaseName: "materialize"}
+		if _, err := materialize.SchemaId(db, o); err == nil {
+			return fmt.Errorf("shadow schema %s still exists", o.Name)
+		}
+		if _, err := materialize.ClusterId(db, o); err == nil {
+			return fmt.Errorf("shadow cluster %s still exists", o.Name)
+		}
+		return nil
+	}
+}
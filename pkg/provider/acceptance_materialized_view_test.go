@@ -3,6 +3,7 @@ package provider
 import (
 	"database/sql"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
@@ -77,6 +78,90 @@ func TestAccMaterializedView_update(t *testing.T) {
 	})
 }
 
+func TestAccMaterializedView_refresh(t *testing.T) {
+	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllMaterializedViewsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaterializedViewRefreshResource(viewName, "1hr"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaterializedViewExists("materialize_materialized_view.test"),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "refresh.0.at_creation", "true"),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "refresh.0.every.0.interval", "1 day"),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "refresh.0.every.0.aligned_to", "2024-01-01 03:00:00"),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "retain_history", "1hr"),
+				),
+			},
+			{
+				Config: testAccMaterializedViewRefreshResource(viewName, "2 hours"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaterializedViewExists("materialize_materialized_view.test"),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "retain_history", "2 hours"),
+				),
+			},
+			{
+				ResourceName:            "materialize_materialized_view.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"statement", "refresh", "retain_history"},
+			},
+		},
+	})
+}
+
+func TestAccMaterializedView_updateCluster(t *testing.T) {
+	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	clusterName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllMaterializedViewsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaterializedViewClusterResource(clusterName, viewName, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaterializedViewExists("materialize_materialized_view.test"),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "cluster_name", "default"),
+				),
+			},
+			{
+				Config: testAccMaterializedViewClusterResource(clusterName, viewName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaterializedViewExists("materialize_materialized_view.test"),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "name", viewName),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "cluster_name", clusterName),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "comment", "moved"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMaterializedView_invalidStatement(t *testing.T) {
+	materializedViewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllMaterializedViewsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "materialize_materialized_view" "test" {
+					name                 = "%[1]s"
+					statement            = "SELECT 1 AS id FORM t"
+					statement_validation = "transaction"
+				}
+				`, materializedViewName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`invalid statement: .* at line 1, column`),
+			},
+		},
+	})
+}
+
 func TestAccMaterializedView_disappears(t *testing.T) {
 	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	view2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -128,6 +213,44 @@ resource "materialize_materialized_view" "test_role" {
 `, roleName, materializeViewName, materializeView2Name, materializeViewOwner)
 }
 
+func testAccMaterializedViewRefreshResource(materializeViewName, retainHistory string) string {
+	return fmt.Sprintf(`
+resource "materialize_materialized_view" "test" {
+	name = "%[1]s"
+	statement = "SELECT 1 AS id"
+	cluster_name = "default"
+
+	refresh {
+		at_creation = true
+		every {
+			interval   = "1 day"
+			aligned_to = "2024-01-01 03:00:00"
+		}
+	}
+
+	retain_history = "%[2]s"
+}
+`, materializeViewName, retainHistory)
+}
+
+func testAccMaterializedViewClusterResource(clusterName, materializeViewName, materializeViewCluster string) string {
+	return fmt.Sprintf(`
+resource "materialize_cluster" "test" {
+	name = "%[1]s"
+	size = "3xsmall"
+}
+
+resource "materialize_materialized_view" "test" {
+	name = "%[2]s"
+	statement = "SELECT 1 AS id"
+	cluster_name = "%[3]s"
+	comment = "moved"
+
+	depends_on = [materialize_cluster.test]
+}
+`, clusterName, materializeViewName, materializeViewCluster)
+}
+
 func testAccCheckMaterializedViewExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
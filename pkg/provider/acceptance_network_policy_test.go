@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccNetworkPolicy_basic(t *testing.T) {
+	policyName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkPolicyResource(policyName, "1.2.3.4/28"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkPolicyExists("materialize_network_policy.test"),
+					resource.TestCheckResourceAttr("materialize_network_policy.test", "name", policyName),
+					resource.TestCheckResourceAttr("materialize_network_policy.test", "qualified_sql_name", fmt.Sprintf(`"%s"`, policyName)),
+					resource.TestCheckResourceAttr("materialize_network_policy.test", "rule.#", "2"),
+					resource.TestCheckResourceAttr("materialize_network_policy.test", "ownership_role", "mz_system"),
+					resource.TestCheckResourceAttr("data.materialize_network_policy.test", "rules.#", "2"),
+					resource.TestCheckResourceAttr("data.materialize_network_policy.test", "rules.0.name", "minnesota"),
+					resource.TestCheckResourceAttr("data.materialize_network_policy.test", "rules.1.address", "1.2.3.4/28"),
+				),
+			},
+			{
+				ResourceName:      "materialize_network_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkPolicy_update(t *testing.T) {
+	policyName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkPolicyResource(policyName, "1.2.3.4/28"),
+			},
+			{
+				Config: testAccNetworkPolicyResource(policyName, "5.6.7.8/32"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkPolicyExists("materialize_network_policy.test"),
+					resource.TestCheckResourceAttr("data.materialize_network_policy.test", "rules.1.address", "5.6.7.8/32"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkPolicy_disappears(t *testing.T) {
+	policyName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllNetworkPoliciesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkPolicyResource(policyName, "1.2.3.4/28"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkPolicyExists("materialize_network_policy.test"),
+					testAccCheckObjectDisappears(
+						materialize.MaterializeObject{
+							ObjectType: "NETWORK POLICY",
+							Name:       policyName,
+						},
+					),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccNetworkPolicyResource(policyName, address string) string {
+	return fmt.Sprintf(`
+resource "materialize_network_policy" "test" {
+	name = "%[1]s"
+
+	rule {
+		name    = "new_york"
+		address = "%[2]s"
+	}
+
+	rule {
+		name    = "minnesota"
+		address = "2.3.4.5/32"
+	}
+}
+
+data "materialize_network_policy" "test" {
+	name = materialize_network_policy.test.name
+}
+`, policyName, address)
+}
+
+func testAccCheckNetworkPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("network policy not found: %s", name)
+		}
+		_, err := materialize.ScanNetworkPolicy(db, r.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckAllNetworkPoliciesDestroyed(s *terraform.State) error {
+	db := testAccProvider.Meta().(*sqlx.DB)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "materialize_network_policy" {
+			continue
+		}
+
+		_, err := materialize.ScanNetworkPolicy(db, r.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("network policy %v still exists", r.Primary.ID)
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccObjectPrivileges_basic(t *testing.T) {
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	databaseName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectPrivilegesResource(roleName, databaseName, "USAGE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGrantExists(
+						materialize.MaterializeObject{ObjectType: "DATABASE", Name: databaseName},
+						"materialize_object_privileges.test", roleName, "USAGE",
+					),
+					resource.TestCheckResourceAttr("materialize_object_privileges.test", "object_type", "DATABASE"),
+					resource.TestCheckResourceAttr("materialize_object_privileges.test", "name", databaseName),
+					resource.TestCheckResourceAttr("materialize_object_privileges.test", "grant.#", "1"),
+					resource.TestCheckResourceAttr("materialize_object_privileges.test", "grant.0.role_name", roleName),
+					resource.TestCheckResourceAttr("materialize_object_privileges.test", "grant.0.privileges.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "materialize_object_privileges.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccObjectPrivileges_revokeUnmanaged(t *testing.T) {
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	databaseName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	o := materialize.MaterializeObject{ObjectType: "DATABASE", Name: databaseName}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectPrivilegesResource(roleName, databaseName, "USAGE"),
+			},
+			{
+				// Granted outside of the resource
+				PreConfig: func() {
+					db := testAccProvider.Meta().(*sqlx.DB)
+					if _, err := db.Exec(fmt.Sprintf(`GRANT CREATE ON DATABASE %s TO "%s";`, o.QualifiedName(), roleName)); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectPrivilegesResource(roleName, databaseName, "USAGE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGrantExists(o, "materialize_object_privileges.test", roleName, "USAGE"),
+					testAccCheckPrivilegeNotExists(o, roleName, "CREATE"),
+					resource.TestCheckResourceAttr("materialize_object_privileges.test", "grant.0.privileges.#", "1"),
+				),
+			},
+			{
+				Config: testAccObjectPrivilegesResource(roleName, databaseName, "CREATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGrantExists(o, "materialize_object_privileges.test", roleName, "CREATE"),
+					testAccCheckPrivilegeNotExists(o, roleName, "USAGE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPrivilegeNotExists(object materialize.MaterializeObject, roleName, privilege string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		id, err := materialize.ObjectId(db, object)
+		if err != nil {
+			return err
+		}
+
+		roleId, err := materialize.RoleId(db, roleName)
+		if err != nil {
+			return err
+		}
+
+		g, err := materialize.ScanPrivileges(db, object.ObjectType, id)
+		if err != nil {
+			return err
+		}
+
+		privilegeMap := materialize.ParsePrivileges(g)
+		if materialize.HasPrivilege(privilegeMap[roleId], privilege) {
+			return fmt.Errorf("object %s still includes privilege %s", g, privilege)
+		}
+		return nil
+	}
+}
+
+func testAccObjectPrivilegesResource(roleName, databaseName, privilege string) string {
+	return fmt.Sprintf(`
+resource "materialize_role" "test" {
+	name = "%s"
+}
+
+resource "materialize_database" "test" {
+	name = "%s"
+}
+
+resource "materialize_object_privileges" "test" {
+	object_type = "DATABASE"
+	name        = materialize_database.test.name
+
+	grant {
+		role_name  = materialize_role.test.name
+		privileges = ["%s"]
+	}
+}
+`, roleName, databaseName, privilege)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"database/sql"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
@@ -84,6 +85,38 @@ func TestAccSinkKafka_update(t *testing.T) {
 	})
 }
 
+func TestAccSinkKafka_updateFrom(t *testing.T) {
+	sinkName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	connName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	tableName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSinkKafkaDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSinkKafkaFromResource(connName, tableName, sinkName, "blue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSinkKafkaExists("materialize_sink_kafka.test"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "from.0.name", tableName+"_blue"),
+				),
+			},
+			{
+				Config: testAccSinkKafkaFromResource(connName, tableName, sinkName, "green"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSinkKafkaExists("materialize_sink_kafka.test"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "from.0.name", tableName+"_green"),
+				),
+			},
+			{
+				Config:      testAccSinkKafkaFromResource(connName, tableName, sinkName, "incompatible"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`the relations are not compatible: column column_2 changes type from integer to text`),
+			},
+		},
+	})
+}
+
 func TestAccSinkKafka_disappears(t *testing.T) {
 	sinkName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	sink2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -183,6 +216,62 @@ resource "materialize_sink_kafka" "test_role" {
 `, roleName, connName, tableName, sinkName, sink2Name, sinkOwner)
 }
 
+func testAccSinkKafkaFromResource(connName, tableName, sinkName, from string) string {
+	return fmt.Sprintf(`
+resource "materialize_connection_kafka" "test" {
+	name = "%[1]s"
+	kafka_broker {
+		broker = "redpanda:9092"
+	}
+}
+
+resource "materialize_table" "test" {
+	name = "%[2]s"
+	column {
+		name = "column_1"
+		type = "text"
+	}
+	column {
+		name = "column_2"
+		type = "int"
+	}
+}
+
+resource "materialize_view" "blue" {
+	name      = "%[2]s_blue"
+	statement = "SELECT column_1, column_2 FROM ${materialize_table.test.qualified_sql_name}"
+}
+
+resource "materialize_view" "green" {
+	name      = "%[2]s_green"
+	statement = "SELECT column_1, column_2, NULL::text AS column_3 FROM ${materialize_table.test.qualified_sql_name}"
+}
+
+resource "materialize_view" "incompatible" {
+	name      = "%[2]s_incompatible"
+	statement = "SELECT column_1, column_2::text AS column_2 FROM ${materialize_table.test.qualified_sql_name}"
+}
+
+resource "materialize_sink_kafka" "test" {
+	name = "%[3]s"
+	kafka_connection {
+		name = materialize_connection_kafka.test.name
+	}
+	from {
+		name = materialize_view.%[4]s.name
+	}
+	size  = "3xsmall"
+	topic = "sink_from_topic"
+	format {
+		json = true
+	}
+	envelope {
+		debezium = true
+	}
+}
+`, connName, tableName, sinkName, from)
+}
+
 func testAccCheckSinkKafkaExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccSourceMysql_basic(t *testing.T) {
+	sourceName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	source2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	secretName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	connName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceMysqlResource(roleName, secretName, connName, sourceName, source2Name, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceMysqlExists("materialize_source_mysql.test"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "name", sourceName),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "database_name", "materialize"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "qualified_sql_name", fmt.Sprintf(`"materialize"."public"."%s"`, sourceName)),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "size", "3xsmall"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "text_columns.#", "1"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.#", "2"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.0.name", "shop.table1"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.0.alias", fmt.Sprintf(`%s_table1`, connName)),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.1.name", "shop.table2"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.1.alias", fmt.Sprintf(`%s_table2`, connName)),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "ownership_role", "mz_system"),
+					testAccCheckSourceMysqlExists("materialize_source_mysql.test_role"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test_role", "name", source2Name),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test_role", "ownership_role", roleName),
+				),
+			},
+			{
+				ResourceName:      "materialize_source_mysql.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+		},
+	})
+}
+
+func TestAccSourceMysql_update(t *testing.T) {
+	slug := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	sourceName := fmt.Sprintf("old_%s", slug)
+	newSourceName := fmt.Sprintf("new_%s", slug)
+	source2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	secretName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	connName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceMysqlResource(roleName, secretName, connName, sourceName, source2Name, "mz_system"),
+			},
+			{
+				Config: testAccSourceMysqlResourceUpdate(roleName, secretName, connName, newSourceName, source2Name, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceMysqlExists("materialize_source_mysql.test"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "name", newSourceName),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "database_name", "materialize"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "qualified_sql_name", fmt.Sprintf(`"materialize"."public"."%s"`, newSourceName)),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "size", "3xsmall"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "text_columns.#", "2"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.#", "2"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.0.name", "shop.table1"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.0.alias", fmt.Sprintf(`%s_table1`, connName)),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.1.name", "shop.table3"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.1.alias", fmt.Sprintf(`%s_table3`, connName)),
+					testAccCheckSourceMysqlExists("materialize_source_mysql.test_role"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test_role", "ownership_role", roleName),
+				),
+			},
+			{
+				Config: testAccSourceMysqlResource(roleName, secretName, connName, newSourceName, source2Name, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceMysqlExists("materialize_source_mysql.test"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.#", "2"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.1.name", "shop.table2"),
+					resource.TestCheckResourceAttr("materialize_source_mysql.test", "table.1.alias", fmt.Sprintf(`%s_table2`, connName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceMysql_disappears(t *testing.T) {
+	sourceName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	source2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	secretName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	connName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSourceMysqlDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceMysqlResource(roleName, secretName, connName, sourceName, source2Name, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceMysqlExists("materialize_source_mysql.test"),
+					testAccCheckObjectDisappears(
+						materialize.MaterializeObject{
+							ObjectType: "SOURCE",
+							Name:       sourceName,
+						},
+					),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSourceMysqlResource(roleName, secretName, connName, sourceName, source2Name, sourceOwner string) string {
+	return fmt.Sprintf(`
+resource "materialize_role" "test" {
+	name = "%[1]s"
+}
+
+resource "materialize_secret" "mysql_password" {
+	name  = "%[2]s"
+	value = "c2VjcmV0Cg=="
+}
+
+resource "materialize_connection_mysql" "test" {
+	name = "%[3]s"
+	host = "mysql"
+	port = 3306
+	user {
+		text = "repluser"
+	}
+	password {
+		name          = materialize_secret.mysql_password.name
+		schema_name   = materialize_secret.mysql_password.schema_name
+		database_name = materialize_secret.mysql_password.database_name
+	}
+}
+
+resource "materialize_source_mysql" "test" {
+	name = "%[4]s"
+	mysql_connection {
+		name = materialize_connection_mysql.test.name
+	}
+
+	size  = "3xsmall"
+	table {
+		name  = "shop.table1"
+		alias = "%[3]s_table1"
+	}
+	table {
+		name  = "shop.table2"
+		alias = "%[3]s_table2"
+	}
+	text_columns = ["shop.table1.id"]
+}
+
+resource "materialize_source_mysql" "test_role" {
+	name = "%[5]s"
+	mysql_connection {
+		name = materialize_connection_mysql.test.name
+	}
+
+	size  = "3xsmall"
+	table {
+		name  = "shop.table1"
+		alias = "%[3]s_table_role_1"
+	}
+	table {
+		name  = "shop.table2"
+		alias = "%[3]s_table_role_2"
+	}
+	ownership_role = "%[6]s"
+
+	depends_on = [materialize_role.test]
+}
+`, roleName, secretName, connName, sourceName, source2Name, sourceOwner)
+}
+
+func testAccSourceMysqlResourceUpdate(roleName, secretName, connName, sourceName, source2Name, sourceOwner string) string {
+	return fmt.Sprintf(`
+resource "materialize_role" "test" {
+	name = "%[1]s"
+}
+
+resource "materialize_secret" "mysql_password" {
+	name  = "%[2]s"
+	value = "c2VjcmV0Cg=="
+}
+
+resource "materialize_connection_mysql" "test" {
+	name = "%[3]s"
+	host = "mysql"
+	port = 3306
+	user {
+		text = "repluser"
+	}
+	password {
+		name          = materialize_secret.mysql_password.name
+		schema_name   = materialize_secret.mysql_password.schema_name
+		database_name = materialize_secret.mysql_password.database_name
+	}
+}
+
+resource "materialize_source_mysql" "test" {
+	name = "%[4]s"
+	mysql_connection {
+		name = materialize_connection_mysql.test.name
+	}
+
+	size  = "3xsmall"
+	table {
+		name  = "shop.table1"
+		alias = "%[3]s_table1"
+	}
+	table {
+		name  = "shop.table3"
+		alias = "%[3]s_table3"
+	}
+	text_columns = ["shop.table1.id", "shop.table3.id"]
+}
+
+resource "materialize_source_mysql" "test_role" {
+	name = "%[5]s"
+	mysql_connection {
+		name = materialize_connection_mysql.test.name
+	}
+
+	size  = "3xsmall"
+	table {
+		name  = "shop.table1"
+		alias = "%[3]s_table_role_1"
+	}
+	table {
+		name  = "shop.table2"
+		alias = "%[3]s_table_role_2"
+	}
+	ownership_role = "%[6]s"
+
+	depends_on = [materialize_role.test]
+}
+`, roleName, secretName, connName, sourceName, source2Name, sourceOwner)
+}
+
+func testAccCheckSourceMysqlExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("source mysql not found: %s", name)
+		}
+		_, err := materialize.ScanSource(db, r.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckAllSourceMysqlDestroyed(s *terraform.State) error {
+	db := testAccProvider.Meta().(*sqlx.DB)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "materialize_source_mysql" {
+			continue
+		}
+
+		_, err := materialize.ScanSource(db, r.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("source %v still exists", r.Primary.ID)
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccSourceTable_basic(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceTableResource(nameSpace, nameSpace+"_table", "mz_system", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceTableExists("materialize_source_table.test"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "name", nameSpace+"_table"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "database_name", "materialize"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "qualified_sql_name", fmt.Sprintf(`"materialize"."public"."%s_table"`, nameSpace)),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "source.0.name", nameSpace+"_source"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "upstream_name", "table3"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "upstream_schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "text_columns.#", "1"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "ownership_role", "mz_system"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "materialize_source_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"text_columns"},
+			},
+		},
+	})
+}
+
+func TestAccSourceTable_update(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceTableResource(nameSpace, nameSpace+"_table", "mz_system", ""),
+			},
+			{
+				Config: testAccSourceTableResource(nameSpace, nameSpace+"_new_table", nameSpace+"_role", "Comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceTableExists("materialize_source_table.test"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "name", nameSpace+"_new_table"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "qualified_sql_name", fmt.Sprintf(`"materialize"."public"."%s_new_table"`, nameSpace)),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "ownership_role", nameSpace+"_role"),
+					resource.TestCheckResourceAttr("materialize_source_table.test", "comment", "Comment"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceTable_disappears(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSourceTableDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceTableResource(nameSpace, nameSpace+"_table", "mz_system", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceTableExists("materialize_source_table.test"),
+					testAccCheckObjectDisappears(
+						materialize.MaterializeObject{
+							ObjectType: "TABLE",
+							Name:       nameSpace + "_table",
+						},
+					),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSourceTableResource(nameSpace, tableName, tableOwner, comment string) string {
+	return fmt.Sprintf(`
+resource "materialize_role" "test" {
+	name = "%[1]s_role"
+}
+
+resource "materialize_secret" "postgres_password" {
+	name  = "%[1]s_secret"
+	value = "c2VjcmV0Cg=="
+}
+
+resource "materialize_connection_postgres" "test" {
+	name = "%[1]s_connection"
+	host = "postgres"
+	port = 5432
+	user {
+		text = "postgres"
+	}
+	password {
+		name = materialize_secret.postgres_password.name
+	}
+	database = "postgres"
+}
+
+resource "materialize_source_postgres" "test" {
+	name = "%[1]s_source"
+	postgres_connection {
+		name = materialize_connection_postgres.test.name
+	}
+
+	size        = "3xsmall"
+	publication = "mz_source"
+	table {
+		name  = "table1"
+		alias = "%[1]s_table1"
+	}
+}
+
+resource "materialize_source_table" "test" {
+	name = "%[2]s"
+	source {
+		name = materialize_source_postgres.test.name
+	}
+
+	upstream_name        = "table3"
+	upstream_schema_name = "public"
+	text_columns         = ["id"]
+
+	ownership_role = "%[3]s"
+	comment        = "%[4]s"
+
+	depends_on = [materialize_role.test]
+}
+`, nameSpace, tableName, tableOwner, comment)
+}
+
+func testAccCheckSourceTableExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("source table not found: %s", name)
+		}
+		_, err := materialize.ScanSourceTable(db, r.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckAllSourceTableDestroyed(s *terraform.State) error {
+	db := testAccProvider.Meta().(*sqlx.DB)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "materialize_source_table" {
+			continue
+		}
+
+		_, err := materialize.ScanSourceTable(db, r.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("source table %v still exists", r.Primary.ID)
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jmoiron/sqlx"
 )
@@ -80,6 +81,51 @@ func TestAccTable_update(t *testing.T) {
 	})
 }
 
+func TestAccTable_addColumn(t *testing.T) {
+	tableName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableColumnsResource(tableName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExists("materialize_table.test"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.#", "1"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.0.default", "'a'"),
+				),
+			},
+			{
+				Config: testAccTableColumnsResource(tableName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("materialize_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExists("materialize_table.test"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.#", "2"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.1.name", "column_2"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.1.comment", "added"),
+				),
+			},
+			{
+				Config: testAccTableColumnsResource(tableName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("materialize_table.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExists("materialize_table.test"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTable_disappears(t *testing.T) {
 	tableName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	tableRoleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -150,6 +196,29 @@ resource "materialize_table" "test_role" {
 `, roleName, tableName, tableRoleName, tableOwnership)
 }
 
+func testAccTableColumnsResource(tableName string, addColumn bool) string {
+	column := ""
+	if addColumn {
+		column = `
+	column {
+		name    = "column_2"
+		type    = "int"
+		comment = "added"
+	}`
+	}
+
+	return fmt.Sprintf(`
+resource "materialize_table" "test" {
+	name = "%[1]s"
+	column {
+		name    = "column_1"
+		type    = "text"
+		default = "'a'"
+	}%[2]s
+}
+`, tableName, column)
+}
+
 func testAccCheckTableExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
@@ -3,6 +3,7 @@ package provider
 import (
 	"database/sql"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
@@ -78,6 +79,93 @@ func TestAccView_update(t *testing.T) {
 	})
 }
 
+func TestAccView_updateStatement(t *testing.T) {
+	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllViewsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccViewStatementResource(viewName, "SELECT 1 AS id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists("materialize_view.test"),
+					resource.TestCheckResourceAttr("materialize_view.test", "statement", "SELECT 1 AS id"),
+				),
+			},
+			{
+				Config: testAccViewStatementResource(viewName, "SELECT 2 AS id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists("materialize_view.test"),
+					resource.TestCheckResourceAttr("materialize_view.test", "name", viewName),
+					resource.TestCheckResourceAttr("materialize_view.test", "statement", "SELECT 2 AS id"),
+					resource.TestCheckResourceAttr("materialize_view.test", "comment", "replaced in place"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccView_statementDrift(t *testing.T) {
+	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllViewsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				// Formatting differences with the catalog definition do not show in the plan
+				Config: testAccViewStatementResource(viewName, "select  1 as id;"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists("materialize_view.test"),
+					resource.TestCheckResourceAttr("materialize_view.test", "statement", "select  1 as id;"),
+				),
+			},
+			{
+				Config: testAccViewStatementResource(viewName, "select  1 as id;"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewReplaced(viewName, "SELECT 2 AS id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccView_updateStatementWithDependents(t *testing.T) {
+	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllViewsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccViewDependentResource(viewName, "SELECT 1 AS id"),
+			},
+			{
+				Config:      testAccViewDependentResource(viewName, "SELECT 2 AS id"),
+				ExpectError: regexp.MustCompile("cannot be replaced in place because it is depended upon by"),
+			},
+		},
+	})
+}
+
+func TestAccView_invalidStatement(t *testing.T) {
+	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllViewsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccViewStatementResource(viewName, "SELECT 1 AS id FORM t"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`invalid statement: .* at line 1, column`),
+			},
+		},
+	})
+}
+
 func TestAccView_disappears(t *testing.T) {
 	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	view2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -126,6 +214,30 @@ resource "materialize_view" "test_role" {
 `, roleName, viewName, view2Name, viewOwner)
 }
 
+func testAccViewStatementResource(viewName, statement string) string {
+	return fmt.Sprintf(`
+resource "materialize_view" "test" {
+	name = "%[1]s"
+	statement = "%[2]s"
+	comment = "replaced in place"
+}
+`, viewName, statement)
+}
+
+func testAccViewDependentResource(viewName, statement string) string {
+	return fmt.Sprintf(`
+resource "materialize_view" "test" {
+	name = "%[1]s"
+	statement = "%[2]s"
+}
+
+resource "materialize_view" "dependent" {
+	name = "%[1]s_dependent"
+	statement = "SELECT id FROM ${materialize_view.test.qualified_sql_name}"
+}
+`, viewName, statement)
+}
+
 func testAccCheckViewExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
@@ -138,6 +250,14 @@ func testAccCheckViewExists(name string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckViewReplaced(viewName, statement string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		_, err := db.Exec(fmt.Sprintf(`CREATE OR REPLACE VIEW "materialize"."public"."%[1]s" AS %[2]s;`, viewName, statement))
+		return err
+	}
+}
+
 func testAccCheckAllViewsDestroyed(s *terraform.State) error {
 	db := testAccProvider.Meta().(*sqlx.DB)
 
@@ -2,14 +2,19 @@ package provider
 
 import (
 	"context"
-	"fmt"
-	"strings"
+	"database/sql"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/datasources"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/resources"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	_ "github.com/jackc/pgx/stdlib"
 	"github.com/jmoiron/sqlx"
 )
@@ -36,6 +41,21 @@ func Provider() *schema.Provider {
 				Description: "Materialize host. Can also come from the `MZ_PASSWORD` environment variable.",
 				DefaultFunc: schema.EnvDefaultFunc("MZ_PASSWORD", nil),
 			},
+			"password_command": {
+				Type:          schema.TypeList,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				MinItems:      1,
+				Description:   "A command and its arguments that print a password, such as a short-lived app password or OAuth token, to stdout. The command runs each time the provider opens a new connection. Conflicts with `password` and `password_file`.",
+				ConflictsWith: []string{"password", "password_file"},
+			},
+			"password_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "A file containing the password, such as a short-lived app password or OAuth token. The file is read each time the provider opens a new connection so it can be rotated externally. Can also come from the `MZ_PASSWORD_FILE` environment variable. Conflicts with `password` and `password_command`.",
+				DefaultFunc:   schema.EnvDefaultFunc("MZ_PASSWORD_FILE", nil),
+				ConflictsWith: []string{"password", "password_command"},
+			},
 			"port": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -60,15 +80,57 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("MZ_SSLMODE", true),
 				Description: "For testing purposes, disable SSL.",
 			},
+			"sslrootcert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path to a file of trusted certificate authorities used to verify the server certificate. Setting it enables `verify-full` verification. Can also come from the `MZ_SSLROOTCERT` environment variable.",
+				DefaultFunc: schema.EnvDefaultFunc("MZ_SSLROOTCERT", nil),
+			},
+			"ssl_verify_full": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Verify the server certificate and host name (`sslmode=verify-full`) using the system certificate authorities or `sslrootcert`. Can also come from the `MZ_SSL_VERIFY_FULL` environment variable. Defaults to false.",
+				DefaultFunc: schema.EnvDefaultFunc("MZ_SSL_VERIFY_FULL", false),
+			},
+			"max_open_connections": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "The maximum number of open connections to Materialize. Defaults to 0, which does not limit the number of connections.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_idle_connections": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				Description:  "The maximum number of idle connections kept in the pool. Defaults to 2.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"connection_max_lifetime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "The maximum number of seconds a connection may be reused. Defaults to 0, which reuses connections indefinitely.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				Description:  "The number of times a statement or catalog query is retried after a transient error such as a serialization failure, a reset connection or a server shutdown. Retries use exponential backoff. Defaults to 3.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"materialize_cluster":                              resources.Cluster(),
 			"materialize_cluster_grant":                        resources.GrantCluster(),
 			"materialize_cluster_grant_default_privilege":      resources.GrantClusterDefaultPrivilege(),
 			"materialize_cluster_replica":                      resources.ClusterReplica(),
+			"materialize_connection_aws":                       resources.ConnectionAws(),
 			"materialize_connection_aws_privatelink":           resources.ConnectionAwsPrivatelink(),
 			"materialize_connection_confluent_schema_registry": resources.ConnectionConfluentSchemaRegistry(),
 			"materialize_connection_kafka":                     resources.ConnectionKafka(),
+			"materialize_connection_mysql":                     resources.ConnectionMysql(),
 			"materialize_connection_postgres":                  resources.ConnectionPostgres(),
 			"materialize_connection_ssh_tunnel":                resources.ConnectionSshTunnel(),
 			"materialize_connection_grant":                     resources.GrantConnection(),
@@ -76,10 +138,13 @@ func Provider() *schema.Provider {
 			"materialize_database":                             resources.Database(),
 			"materialize_database_grant":                       resources.GrantDatabase(),
 			"materialize_database_grant_default_privilege":     resources.GrantDatabaseDefaultPrivilege(),
+			"materialize_deployment":                           resources.Deployment(),
 			"materialize_grant_system_privilege":               resources.GrantSystemPrivilege(),
 			"materialize_index":                                resources.Index(),
 			"materialize_materialized_view":                    resources.MaterializedView(),
 			"materialize_materialized_view_grant":              resources.GrantMaterializedView(),
+			"materialize_network_policy":                       resources.NetworkPolicy(),
+			"materialize_object_privileges":                    resources.ObjectPrivileges(),
 			"materialize_role":                                 resources.Role(),
 			"materialize_role_grant":                           resources.GrantRole(),
 			"materialize_schema":                               resources.Schema(),
@@ -91,8 +156,10 @@ func Provider() *schema.Provider {
 			"materialize_sink_kafka":                           resources.SinkKafka(),
 			"materialize_source_kafka":                         resources.SourceKafka(),
 			"materialize_source_load_generator":                resources.SourceLoadgen(),
+			"materialize_source_mysql":                         resources.SourceMysql(),
 			"materialize_source_postgres":                      resources.SourcePostgres(),
 			"materialize_source_webhook":                       resources.SourceWebhook(),
+			"materialize_source_table":                         resources.SourceTable(),
 			"materialize_source_grant":                         resources.GrantSource(),
 			"materialize_table":                                resources.Table(),
 			"materialize_table_grant":                          resources.GrantTable(),
@@ -104,45 +171,61 @@ func Provider() *schema.Provider {
 			"materialize_view_grant":                           resources.GrantView(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"materialize_cluster":           datasources.Cluster(),
-			"materialize_cluster_replica":   datasources.ClusterReplica(),
-			"materialize_connection":        datasources.Connection(),
-			"materialize_current_database":  datasources.CurrentDatabase(),
-			"materialize_current_cluster":   datasources.CurrentCluster(),
-			"materialize_database":          datasources.Database(),
-			"materialize_egress_ips":        datasources.EgressIps(),
-			"materialize_index":             datasources.Index(),
-			"materialize_materialized_view": datasources.MaterializedView(),
-			"materialize_role":              datasources.Role(),
-			"materialize_schema":            datasources.Schema(),
-			"materialize_secret":            datasources.Secret(),
-			"materialize_sink":              datasources.Sink(),
-			"materialize_source":            datasources.Source(),
-			"materialize_table":             datasources.Table(),
-			"materialize_type":              datasources.Type(),
-			"materialize_view":              datasources.View(),
+			"materialize_cluster":                    datasources.Cluster(),
+			"materialize_cluster_replica":            datasources.ClusterReplica(),
+			"materialize_connection":                 datasources.Connection(),
+			"materialize_connection_aws_privatelink": datasources.ConnectionAwsPrivatelink(),
+			"materialize_connection_validation":      datasources.ConnectionValidation(),
+			"materialize_current_database":           datasources.CurrentDatabase(),
+			"materialize_current_cluster":            datasources.CurrentCluster(),
+			"materialize_database":                   datasources.Database(),
+			"materialize_egress_ips":                 datasources.EgressIps(),
+			"materialize_index":                      datasources.Index(),
+			"materialize_materialized_view":          datasources.MaterializedView(),
+			"materialize_network_policy":             datasources.NetworkPolicy(),
+			"materialize_role":                       datasources.Role(),
+			"materialize_schema":                     datasources.Schema(),
+			"materialize_secret":                     datasources.Secret(),
+			"materialize_sink":                       datasources.Sink(),
+			"materialize_source":                     datasources.Source(),
+			"materialize_source_status":              datasources.SourceStatus(),
+			"materialize_table":                      datasources.Table(),
+			"materialize_type":                       datasources.Type(),
+			"materialize_view":                       datasources.View(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
 }
 
-func connectionString(host, user, password string, port int, database string, sslmode bool, application string) string {
-	c := strings.Builder{}
-	c.WriteString(fmt.Sprintf("postgres://%s:%s@%s:%d/%s", user, password, host, port, database))
-
-	params := []string{}
-
-	if sslmode {
-		params = append(params, "sslmode=require")
-	} else {
-		params = append(params, "sslmode=disable")
+// Returns the sslmode for the connection string
+func sslMode(sslmode, verifyFull bool, sslrootcert string) string {
+	switch {
+	case !sslmode:
+		return "disable"
+	case verifyFull || sslrootcert != "":
+		return "verify-full"
+	default:
+		return "require"
 	}
+}
 
-	params = append(params, fmt.Sprintf("application_name=%s", application))
-	p := strings.Join(params[:], "&")
+// Builds the connection URL escaping the credentials, database and parameters
+func connectionString(host, user, password string, port int, database, sslmode, sslrootcert, application string) string {
+	params := url.Values{}
+	params.Set("sslmode", sslmode)
+	if sslrootcert != "" {
+		params.Set("sslrootcert", sslrootcert)
+	}
+	params.Set("application_name", application)
 
-	c.WriteString(fmt.Sprintf("?%s", p))
-	return c.String()
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(user, password),
+		Host:     net.JoinHostPort(host, strconv.Itoa(port)),
+		Path:     "/" + database,
+		RawQuery: params.Encode(),
+	}
+	return u.String()
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -153,11 +236,31 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	database := d.Get("database").(string)
 	application := d.Get("application_name").(string)
 	sslmode := d.Get("sslmode").(bool)
+	sslrootcert := d.Get("sslrootcert").(string)
+	verifyFull := d.Get("ssl_verify_full").(bool)
+	maxOpenConnections := d.Get("max_open_connections").(int)
+	maxIdleConnections := d.Get("max_idle_connections").(int)
+	connectionMaxLifetime := d.Get("connection_max_lifetime").(int)
+	maxRetries := d.Get("max_retries").(int)
 
-	connStr := connectionString(host, user, password, port, database, sslmode, application)
+	mode := sslMode(sslmode, verifyFull, sslrootcert)
 
 	var diags diag.Diagnostics
-	db, err := sqlx.Open("pgx", connStr)
+	var db *sqlx.DB
+	var err error
+	if source := passwordSource(d); source != nil {
+		// Passwords from a token source are fetched for every new connection
+		c := &tokenConnector{
+			source: source,
+			dsn: func(password string) string {
+				return connectionString(host, user, password, port, database, mode, sslrootcert, application)
+			},
+		}
+		db = sqlx.NewDb(sql.OpenDB(c), "pgx")
+	} else {
+		connStr := connectionString(host, user, password, port, database, mode, sslrootcert, application)
+		db, err = sqlx.Open("pgx", connStr)
+	}
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -167,5 +270,13 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		return nil, diags
 	}
 
+	db.SetMaxOpenConns(maxOpenConnections)
+	db.SetMaxIdleConns(maxIdleConnections)
+	db.SetConnMaxLifetime(time.Duration(connectionMaxLifetime) * time.Second)
+
+	retryPolicy := materialize.DefaultRetryPolicy()
+	retryPolicy.MaxRetries = maxRetries
+	materialize.SetRetryPolicy(retryPolicy)
+
 	return db, diags
 }
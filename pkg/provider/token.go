@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/stdlib"
+)
+
+// Returns a password such as an app password or OAuth token. Sources are
+// called for every new connection so short-lived credentials can be rotated
+type tokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// Runs a command and uses its stdout as the password
+type execTokenSource struct {
+	command []string
+}
+
+func (s execTokenSource) Token(ctx context.Context) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.command[0], s.command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("password command %s failed: %w: %s", s.command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	t := strings.TrimSpace(stdout.String())
+	if t == "" {
+		return "", fmt.Errorf("password command %s returned an empty password", s.command[0])
+	}
+	return t, nil
+}
+
+// Reads the password from a file
+type fileTokenSource struct {
+	path string
+}
+
+func (s fileTokenSource) Token(ctx context.Context) (string, error) {
+	b, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read password file: %w", err)
+	}
+
+	t := strings.TrimSpace(string(b))
+	if t == "" {
+		return "", fmt.Errorf("password file %s is empty", s.path)
+	}
+	return t, nil
+}
+
+// Returns the token source configured on the provider, if any
+func passwordSource(d *schema.ResourceData) tokenSource {
+	if v, ok := d.GetOk("password_command"); ok {
+		var command []string
+		for _, c := range v.([]interface{}) {
+			command = append(command, c.(string))
+		}
+		return execTokenSource{command: command}
+	}
+
+	if v, ok := d.GetOk("password_file"); ok {
+		return fileTokenSource{path: v.(string)}
+	}
+
+	return nil
+}
+
+// Opens pgx connections with a password fetched from the token source
+type tokenConnector struct {
+	source tokenSource
+	dsn    func(password string) string
+}
+
+func (c *tokenConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	password, err := c.source.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return stdlib.GetDefaultDriver().Open(c.dsn(password))
+}
+
+func (c *tokenConnector) Driver() driver.Driver {
+	return stdlib.GetDefaultDriver()
+}
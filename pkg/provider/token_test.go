@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecTokenSource(t *testing.T) {
+	r := require.New(t)
+	s := execTokenSource{command: []string{"echo", "token"}}
+
+	token, err := s.Token(context.TODO())
+	r.NoError(err)
+	r.Equal("token", token)
+}
+
+func TestExecTokenSourceFailure(t *testing.T) {
+	r := require.New(t)
+	s := execTokenSource{command: []string{"false"}}
+
+	_, err := s.Token(context.TODO())
+	r.Error(err)
+}
+
+func TestFileTokenSource(t *testing.T) {
+	r := require.New(t)
+	path := filepath.Join(t.TempDir(), "token")
+	r.NoError(os.WriteFile(path, []byte("token\n"), 0600))
+	s := fileTokenSource{path: path}
+
+	token, err := s.Token(context.TODO())
+	r.NoError(err)
+	r.Equal("token", token)
+
+	// Rotated tokens are picked up on the next read
+	r.NoError(os.WriteFile(path, []byte("rotated\n"), 0600))
+	token, err = s.Token(context.TODO())
+	r.NoError(err)
+	r.Equal("rotated", token)
+}
+
+func TestFileTokenSourceEmpty(t *testing.T) {
+	r := require.New(t)
+	path := filepath.Join(t.TempDir(), "token")
+	r.NoError(os.WriteFile(path, []byte("\n"), 0600))
+	s := fileTokenSource{path: path}
+
+	_, err := s.Token(context.TODO())
+	r.Error(err)
+}
+
+func TestPasswordSource(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"password": "pass"})
+	r.Nil(passwordSource(d))
+
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"password_command": []interface{}{"echo", "token"}})
+	r.Equal(execTokenSource{command: []string{"echo", "token"}}, passwordSource(d))
+
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"password_file": "/tmp/token"})
+	r.Equal(fileTokenSource{path: "/tmp/token"}, passwordSource(d))
+}
@@ -23,6 +23,13 @@ var replicaSizes = []string{
 	"6xlarge",
 }
 
+var mysqlSSLModes = []string{
+	"disabled",
+	"required",
+	"verify_ca",
+	"verify_identity",
+}
+
 var saslMechanisms = []string{
 	"PLAIN",
 	"SCRAM-SHA-256",
@@ -3,12 +3,15 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -18,7 +21,7 @@ var clusterSchema = map[string]*schema.Schema{
 	"ownership_role": OwnershipRoleSchema(),
 	"size":           SizeSchema("managed cluster", false, false),
 	"replication_factor": {
-		Description:  "The number of replicas of each dataflow-powered object to maintain.",
+		Description:  "The number of replicas of each dataflow-powered object to maintain. Cannot be set when the cluster is scheduled `on_refresh`.",
 		Type:         schema.TypeInt,
 		Optional:     true,
 		RequiredWith: []string{"size"},
@@ -34,6 +37,61 @@ var clusterSchema = map[string]*schema.Schema{
 	"introspection_interval":        IntrospectionIntervalSchema(false, []string{"size"}),
 	"introspection_debugging":       IntrospectionDebuggingSchema(false, []string{"size"}),
 	"idle_arrangement_merge_effort": IdleArrangementMergeEffortSchema(false, []string{"size"}),
+	"scheduling": {
+		Description:  "Defines the scheduling of the cluster. Clusters that only maintain materialized views with a refresh strategy can be turned on when a refresh is due and off once it completes.",
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		RequiredWith: []string{"size"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"on_refresh": {
+					Description: "Turn the cluster on only while the materialized views it maintains are refreshing. The replication factor of the cluster is managed by Materialize while enabled.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+				"hydration_time_estimate": {
+					Description:      "An estimate of how long the cluster takes to hydrate, such as `1 hour`. The cluster is turned on this long before a refresh is due.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateFunc:     validateInterval,
+					DiffSuppressFunc: suppressIntervalDiff,
+				},
+			},
+		},
+	},
+	"wait_until_ready": {
+		Description:  "Resize the cluster gracefully. New replicas are provisioned alongside the existing ones and only replace them once they are hydrated, avoiding downtime. Only applies to changes of `size`.",
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		RequiredWith: []string{"size"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Description: "Enable graceful reconfiguration.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+				"timeout": {
+					Description:  "The maximum time to wait for the new replicas to hydrate, such as `10 minutes`.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "10 minutes",
+					ValidateFunc: validateInterval,
+				},
+				"on_timeout": {
+					Description:  "The action to take if the timeout is reached. `COMMIT` cuts over to the new replicas regardless of their hydration, `ROLLBACK` keeps the existing replicas and fails the update.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "COMMIT",
+					ValidateFunc: validation.StringInSlice([]string{"COMMIT", "ROLLBACK"}, true),
+				},
+			},
+		},
+	},
 }
 
 func Cluster() *schema.Resource {
@@ -46,9 +104,11 @@ func Cluster() *schema.Resource {
 		DeleteContext: clusterDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importWithDefaults(clusterSchema, "introspection_interval", "introspection_debugging"),
 		},
 
+		CustomizeDiff: clusterCustomizeDiff,
+
 		Schema: clusterSchema,
 	}
 }
@@ -73,7 +133,17 @@ func clusterRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("replication_factor", s.ReplicationFactor.Int64); err != nil {
+	// The replication factor of scheduled clusters is managed by Materialize
+	var replicationFactor int64
+	if s.ScheduleType.String != "on-refresh" {
+		replicationFactor = s.ReplicationFactor.Int64
+	}
+
+	if err := d.Set("replication_factor", replicationFactor); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("scheduling", clusterSchedulingState(d.Get("scheduling").([]interface{}), s)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -127,6 +197,10 @@ func clusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 		if v, ok := d.GetOk("idle_arrangement_merge_effort"); ok {
 			b.IdleArrangementMergeEffort(v.(int))
 		}
+
+		if v, ok := d.GetOk("scheduling"); ok {
+			b.Scheduling(clusterScheduling(v.([]interface{})))
+		}
 	}
 
 	// create resource
@@ -181,12 +255,21 @@ func clusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 	b := materialize.NewClusterBuilder(meta.(*sqlx.DB), o)
 	if _, ok := d.GetOk("size"); ok {
+		scheduling := clusterScheduling(d.Get("scheduling").([]interface{}))
+
+		// Switch the schedule first so a manual replication factor can be set
+		if d.HasChange("scheduling") {
+			if err := b.SetScheduling(scheduling); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
 		if d.HasChange("size") {
 			_, newSize := d.GetChange("size")
-			if err := b.Resize(newSize.(string)); err != nil {
+			wait := clusterWaitUntilReady(d.Get("wait_until_ready").([]interface{}))
+			if err := b.Resize(newSize.(string), wait); err != nil {
 				return diag.FromErr(err)
 			}
-
 		}
 
 		if d.HasChange("disk") {
@@ -196,7 +279,7 @@ func clusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}
 			}
 		}
 
-		if d.HasChange("replication_factor") {
+		if d.HasChange("replication_factor") && !scheduling.OnRefresh {
 			_, n := d.GetChange("replication_factor")
 			if err := b.SetReplicationFactor(n.(int)); err != nil {
 				return diag.FromErr(err)
@@ -246,6 +329,69 @@ func clusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}
 	return clusterRead(ctx, d, meta)
 }
 
+// Materialize manages the replication factor of clusters scheduled on refresh
+func clusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	scheduling := clusterScheduling(d.Get("scheduling").([]interface{}))
+
+	if scheduling.OnRefresh && d.Get("replication_factor").(int) != 0 {
+		return fmt.Errorf("replication_factor cannot be set when the cluster is scheduled on_refresh, Materialize manages the replication factor of scheduled clusters")
+	}
+
+	return nil
+}
+
+func clusterScheduling(v []interface{}) materialize.SchedulingStruct {
+	var s materialize.SchedulingStruct
+	if len(v) == 0 || v[0] == nil {
+		return s
+	}
+
+	u := v[0].(map[string]interface{})
+	s.OnRefresh = u["on_refresh"].(bool)
+	s.HydrationTimeEstimate = u["hydration_time_estimate"].(string)
+	return s
+}
+
+func clusterWaitUntilReady(v []interface{}) materialize.WaitUntilReadyStruct {
+	var w materialize.WaitUntilReadyStruct
+	if len(v) == 0 || v[0] == nil {
+		return w
+	}
+
+	u := v[0].(map[string]interface{})
+	w.Enabled = u["enabled"].(bool)
+	w.Timeout = u["timeout"].(string)
+	w.OnTimeout = strings.ToUpper(u["on_timeout"].(string))
+	return w
+}
+
+// Builds the scheduling block from the catalog. Manual scheduling is the
+// default so it is only kept in state when it was configured
+func clusterSchedulingState(current []interface{}, s materialize.ClusterParams) []interface{} {
+	if s.ScheduleType.String != "on-refresh" {
+		if len(current) == 0 {
+			return nil
+		}
+		return []interface{}{map[string]interface{}{
+			"on_refresh":              false,
+			"hydration_time_estimate": "",
+		}}
+	}
+
+	// An unset estimate is read back as a zero interval
+	estimate := s.HydrationEstimate.String
+	if c := clusterScheduling(current).HydrationTimeEstimate; materialize.EquivalentIntervals(c, estimate) {
+		estimate = c
+	} else if e, err := materialize.ParseInterval(estimate); err == nil && e == 0 {
+		estimate = ""
+	}
+
+	return []interface{}{map[string]interface{}{
+		"on_refresh":              true,
+		"hydration_time_estimate": estimate,
+	}}
+}
+
 func clusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterName := d.Get("name").(string)
 
@@ -28,6 +28,7 @@ var clusterReplicaSchema = map[string]*schema.Schema{
 	"introspection_interval":        IntrospectionIntervalSchema(true, []string{}),
 	"introspection_debugging":       IntrospectionDebuggingSchema(true, []string{}),
 	"idle_arrangement_merge_effort": IdleArrangementMergeEffortSchema(true, []string{}),
+	"wait_for_ready":                WaitForReadySchema("replica", "ready"),
 }
 
 func ClusterReplica() *schema.Resource {
@@ -40,9 +41,11 @@ func ClusterReplica() *schema.Resource {
 		DeleteContext: clusterReplicaDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importWithDefaults(clusterReplicaSchema, "introspection_interval", "introspection_debugging", "wait_for_ready"),
 		},
 
+		Timeouts: ReadyTimeouts(),
+
 		Schema: clusterReplicaSchema,
 	}
 }
@@ -145,6 +148,12 @@ func clusterReplicaCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.SetId(i)
 
+	if waitForReady(d) {
+		if err := waitForClusterReplicaReady(ctx, meta.(*sqlx.DB), i, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return clusterReplicaRead(ctx, d, meta)
 }
 
@@ -180,5 +189,10 @@ func clusterReplicaDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+
+	err := waitForObjectDropped(ctx, "cluster replica", d.Id(), d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := materialize.ScanClusterReplica(meta.(*sqlx.DB), d.Id())
+		return err
+	})
+	return diag.FromErr(err)
 }
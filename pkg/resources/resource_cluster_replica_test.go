@@ -56,6 +56,40 @@ func TestResourceClusterReplicaCreate(t *testing.T) {
 	})
 }
 
+func TestResourceClusterReplicaCreateWaitForReady(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":           "replica",
+		"cluster_name":   "cluster",
+		"size":           "small",
+		"wait_for_ready": true,
+	}
+	d := schema.TestResourceDataRaw(t, ClusterReplica().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(`CREATE CLUSTER REPLICA "cluster"."replica" SIZE = 'small', INTROSPECTION INTERVAL = '1s';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_cluster_replicas.name = 'replica' AND mz_clusters.name = 'cluster'`
+		testhelpers.MockClusterReplicaScan(mock, ip)
+
+		// Wait for ready
+		sp := `WHERE mz_cluster_replica_statuses.replica_id = 'u1'`
+		testhelpers.MockClusterReplicaStatusScan(mock, sp, "ready", "")
+
+		// Query Params
+		pp := `WHERE mz_cluster_replicas.id = 'u1'`
+		testhelpers.MockClusterReplicaScan(mock, pp)
+
+		if err := clusterReplicaCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestResourceClusterReplicaDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -65,10 +99,14 @@ func TestResourceClusterReplicaDelete(t *testing.T) {
 	}
 	d := schema.TestResourceDataRaw(t, ClusterReplica().Schema, in)
 	r.NotNil(d)
+	d.SetId("u1")
 
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP CLUSTER REPLICA "cluster"."replica";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
+		// Query dropped object
+		mock.ExpectQuery(`SELECT .* WHERE mz_cluster_replicas.id = \$1;`).WithArgs("u1").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
 		if err := clusterReplicaDelete(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
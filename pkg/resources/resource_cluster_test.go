@@ -8,6 +8,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -50,6 +51,70 @@ func TestResourceClusterCreate(t *testing.T) {
 	})
 }
 
+func TestResourceClusterCreateScheduling(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name": "cluster",
+		"size": "1",
+		"scheduling": []interface{}{map[string]interface{}{
+			"on_refresh":              true,
+			"hydration_time_estimate": "1 hour",
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, Cluster().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(`CREATE CLUSTER "cluster" SIZE '1', INTROSPECTION INTERVAL = '1s', SCHEDULE = ON REFRESH \(HYDRATION TIME ESTIMATE = '1 hour'\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_clusters.name = 'cluster'`
+		testhelpers.MockClusterScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_clusters.id = 'u1'`
+		testhelpers.MockClusterScan(mock, pp)
+
+		if err := clusterCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceClusterUpdateWaitUntilReady(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":               "cluster",
+		"size":               "2",
+		"replication_factor": 2,
+		"wait_until_ready": []interface{}{map[string]interface{}{
+			"enabled":    true,
+			"timeout":    "10 minutes",
+			"on_timeout": "rollback",
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, Cluster().Schema, in)
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SET \(SIZE '2'\) WITH \(WAIT UNTIL READY \(TIMEOUT = '10 minutes', ON TIMEOUT = 'ROLLBACK'\)\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SET \(REPLICATION FACTOR 2\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SET \(INTROSPECTION INTERVAL '1s'\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_clusters.id = 'u1'`
+		testhelpers.MockClusterScan(mock, pp)
+
+		if err := clusterUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestResourceClusterDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -64,3 +129,35 @@ func TestResourceClusterDelete(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceClusterImport(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, Cluster().Schema, map[string]interface{}{})
+	d.SetId("u1")
+
+	s, err := Cluster().Importer.StateContext(context.TODO(), d, nil)
+	r.NoError(err)
+	r.Len(s, 1)
+
+	a := d.State().Attributes
+	r.Equal("1s", a["introspection_interval"])
+	r.Equal("false", a["introspection_debugging"])
+}
+
+func TestResourceClusterSchedulingReplicationFactor(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":               "cluster",
+		"size":               "1",
+		"replication_factor": 2,
+		"scheduling":         []interface{}{map[string]interface{}{"on_refresh": true}},
+	}
+	_, err := Cluster().Diff(context.TODO(), nil, terraform.NewResourceConfigRaw(in), nil)
+	r.ErrorContains(err, "replication_factor cannot be set when the cluster is scheduled on_refresh")
+
+	delete(in, "replication_factor")
+	_, err = Cluster().Diff(context.TODO(), nil, terraform.NewResourceConfigRaw(in), nil)
+	r.NoError(err)
+}
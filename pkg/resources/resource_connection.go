@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 
@@ -53,6 +54,12 @@ func connectionRead(ctx context.Context, d *schema.ResourceData, meta interface{
 }
 
 func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return connectionUpdateOptions(ctx, d, meta, nil)
+}
+
+// Renames the connection before altering the options of changed attributes,
+// as the options are set on the connection by its new name
+func connectionUpdateOptions(ctx context.Context, d *schema.ResourceData, meta interface{}, options []connectionOption) diag.Diagnostics {
 	connectionName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
 	databaseName := d.Get("database_name").(string)
@@ -68,6 +75,10 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if err := connectionAlter(d, meta, options); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
 		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
@@ -102,3 +113,106 @@ func connectionDelete(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 	return nil
 }
+
+// An attribute of a connection resource that is changed in place with
+// ALTER CONNECTION instead of recreating the connection
+type connectionOption struct {
+	attribute string
+	option    string
+	value     func(d *schema.ResourceData) string
+}
+
+// Options of the changed attributes in the order they are listed. Attributes
+// that set the same option, such as the Kafka brokers and SSH tunnel, alter it once
+func changedConnectionOptions(d *schema.ResourceData, options []connectionOption) []materialize.ConnectionOption {
+	var c []materialize.ConnectionOption
+	seen := map[string]bool{}
+	for _, o := range options {
+		if seen[o.option] || !d.HasChange(o.attribute) {
+			continue
+		}
+		seen[o.option] = true
+		c = append(c, materialize.ConnectionOption{Name: o.option, Value: o.value(d)})
+	}
+	return c
+}
+
+func connectionAlter(d *schema.ResourceData, meta interface{}, options []connectionOption) error {
+	c := changedConnectionOptions(d, options)
+	if len(c) == 0 {
+		return nil
+	}
+
+	validate := true
+	if v, ok := d.Get("validate").(bool); ok {
+		validate = v
+	}
+
+	o := materialize.MaterializeObject{
+		ObjectType:   "CONNECTION",
+		Name:         d.Get("name").(string),
+		SchemaName:   d.Get("schema_name").(string),
+		DatabaseName: d.Get("database_name").(string),
+	}
+	return materialize.NewConnection(meta.(*sqlx.DB), o).Alter(c, validate)
+}
+
+func stringOption(attribute string) func(d *schema.ResourceData) string {
+	return func(d *schema.ResourceData) string {
+		if v := d.Get(attribute).(string); v != "" {
+			return materialize.QuoteString(v)
+		}
+		return ""
+	}
+}
+
+func intOption(attribute string) func(d *schema.ResourceData) string {
+	return func(d *schema.ResourceData) string {
+		if v := d.Get(attribute).(int); v != 0 {
+			return fmt.Sprintf("%d", v)
+		}
+		return ""
+	}
+}
+
+func valueSecretOption(attribute string) func(d *schema.ResourceData) string {
+	return func(d *schema.ResourceData) string {
+		v, ok := d.GetOk(attribute)
+		if !ok {
+			return ""
+		}
+		return materialize.GetValueSecretStruct(d.Get("database_name").(string), d.Get("schema_name").(string), v).OptionValue()
+	}
+}
+
+func secretOption(attribute string) func(d *schema.ResourceData) string {
+	return func(d *schema.ResourceData) string {
+		v, ok := d.GetOk(attribute)
+		if !ok {
+			return ""
+		}
+		return materialize.GetIdentifierSchemaStruct(d.Get("database_name").(string), d.Get("schema_name").(string), v).SecretOptionValue()
+	}
+}
+
+func identifierOption(attribute string) func(d *schema.ResourceData) string {
+	return func(d *schema.ResourceData) string {
+		v, ok := d.GetOk(attribute)
+		if !ok {
+			return ""
+		}
+		return materialize.GetIdentifierSchemaStruct(d.Get("database_name").(string), d.Get("schema_name").(string), v).OptionValue()
+	}
+}
+
+// Clears ForceNew from the attribute and its nested blocks so changes are
+// applied with ALTER CONNECTION
+func alterableSchema(s *schema.Schema) *schema.Schema {
+	s.ForceNew = false
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		for _, e := range r.Schema {
+			alterableSchema(e)
+		}
+	}
+	return s
+}
@@ -0,0 +1,281 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var connectionAwsSchema = map[string]*schema.Schema{
+	"name":               ObjectNameSchema("connection", true, false),
+	"schema_name":        SchemaNameSchema("connection", false),
+	"database_name":      DatabaseNameSchema("connection", false),
+	"qualified_sql_name": QualifiedNameSchema("connection"),
+	"comment":            CommentSchema(false),
+	"endpoint": {
+		Description: "Override the default AWS endpoint URL. Allows targeting S3-compatible services like MinIO.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"region": {
+		Description: "The AWS region to connect to.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"access_key_id":     connectionAwsAccessKeyIdSchema(),
+	"secret_access_key": connectionAwsSecretAccessKeySchema(),
+	"session_token":     ValueSecretSchema("session_token", "The session token associated with the temporary AWS credentials.", false),
+	"assume_role_arn": {
+		Description:  "The Amazon Resource Name (ARN) of the IAM role to assume. Conflicts with `access_key_id`.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"access_key_id", "assume_role_arn"},
+	},
+	"assume_role_session_name": {
+		Description:  "The session name to use when assuming the role.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		RequiredWith: []string{"assume_role_arn"},
+	},
+	"principal": {
+		Description: "The ARN of the AWS principal Materialize uses to assume the role. Use it in the trust policy of the role.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"external_id": {
+		Description: "The external ID Materialize passes when assuming the role. Use it as the `sts:ExternalId` condition in the trust policy of the role.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"example_trust_policy": {
+		Description: "An example trust policy for the role, as JSON, allowing Materialize to assume it.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"ownership_role": OwnershipRoleSchema(),
+}
+
+func connectionAwsAccessKeyIdSchema() *schema.Schema {
+	s := ValueSecretSchema("access_key_id", "The access key ID to connect with. Conflicts with `assume_role_arn`.", false)
+	s.ExactlyOneOf = []string{"access_key_id", "assume_role_arn"}
+	s.RequiredWith = []string{"secret_access_key"}
+	return s
+}
+
+func connectionAwsSecretAccessKeySchema() *schema.Schema {
+	s := IdentifierSchema("secret_access_key", "The secret holding the secret access key to connect with.", false)
+	s.RequiredWith = []string{"access_key_id"}
+	return s
+}
+
+func ConnectionAws() *schema.Resource {
+	return &schema.Resource{
+		Description: "An AWS connection provides IAM credentials to other connections and sources that integrate with AWS, either by assuming a role or with static access keys.",
+
+		CreateContext: connectionAwsCreate,
+		ReadContext:   connectionAwsRead,
+		UpdateContext: connectionAwsUpdate,
+		DeleteContext: connectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: connectionAwsSchema,
+	}
+}
+
+func connectionAwsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	s, err := materialize.ScanConnectionAws(meta.(*sqlx.DB), i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(i)
+
+	if err := d.Set("name", s.ConnectionName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("schema_name", s.SchemaName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("database_name", s.DatabaseName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("endpoint", s.Endpoint.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("region", s.Region.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("assume_role_arn", s.AssumeRoleArn.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("assume_role_session_name", s.AssumeRoleSessionName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Access key ids held in a secret are not exposed by the catalog
+	if s.AccessKeyId.Valid {
+		if err := d.Set("access_key_id", []interface{}{map[string]interface{}{"text": s.AccessKeyId.String}}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("principal", s.Principal.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("external_id", s.ExternalId.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("example_trust_policy", s.ExampleTrustPolicy.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("ownership_role", s.OwnerName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	b := materialize.Connection{ConnectionName: s.ConnectionName.String, SchemaName: s.SchemaName.String, DatabaseName: s.DatabaseName.String}
+	if err := d.Set("qualified_sql_name", b.QualifiedName()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("comment", s.Comment.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func connectionAwsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "CONNECTION", Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewConnectionAwsBuilder(meta.(*sqlx.DB), o)
+
+	if v, ok := d.GetOk("endpoint"); ok {
+		b.Endpoint(v.(string))
+	}
+
+	if v, ok := d.GetOk("region"); ok {
+		b.Region(v.(string))
+	}
+
+	if v, ok := d.GetOk("access_key_id"); ok {
+		b.AccessKeyId(materialize.GetValueSecretStruct(databaseName, schemaName, v))
+	}
+
+	if v, ok := d.GetOk("secret_access_key"); ok {
+		b.SecretAccessKey(materialize.GetIdentifierSchemaStruct(databaseName, schemaName, v))
+	}
+
+	if v, ok := d.GetOk("session_token"); ok {
+		b.SessionToken(materialize.GetValueSecretStruct(databaseName, schemaName, v))
+	}
+
+	if v, ok := d.GetOk("assume_role_arn"); ok {
+		b.AssumeRoleArn(v.(string))
+	}
+
+	if v, ok := d.GetOk("assume_role_session_name"); ok {
+		b.AssumeRoleSessionName(v.(string))
+	}
+
+	// create resource
+	if err := b.Create(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// ownership
+	if v, ok := d.GetOk("ownership_role"); ok {
+		ownership := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
+
+		if err := ownership.Alter(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed ownership, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// object comment
+	if v, ok := d.GetOk("comment"); ok {
+		comment := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+		if err := comment.Object(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// set id
+	i, err := materialize.ConnectionId(meta.(*sqlx.DB), o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(i)
+
+	return connectionAwsRead(ctx, d, meta)
+}
+
+func connectionAwsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "CONNECTION", Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
+
+	if d.HasChange("name") {
+		oldName, newName := d.GetChange("name")
+		o := materialize.MaterializeObject{ObjectType: "CONNECTION", Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
+		b := materialize.NewConnection(meta.(*sqlx.DB), o)
+		if err := b.Rename(newName.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("ownership_role") {
+		_, newRole := d.GetChange("ownership_role")
+		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
+		if err := b.Alter(newRole.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		_, newComment := d.GetChange("comment")
+		b := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+		if err := b.Object(newComment.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return connectionAwsRead(ctx, d, meta)
+}
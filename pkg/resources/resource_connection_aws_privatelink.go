@@ -37,6 +37,16 @@ var connectionAwsPrivatelinkSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Sensitive:   true,
 	},
+	"status": {
+		Description: "The status of the AWS PrivateLink connection, such as `pending-service-discovery` or `available`. The connection becomes `available` once the endpoint service accepts the `principal`.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"last_status_change_at": {
+		Description: "The time of the last status change of the AWS PrivateLink connection.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
 	"ownership_role": OwnershipRoleSchema(),
 }
 
@@ -93,6 +103,14 @@ func connectionAwsPrivatelinkRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("status", s.Status.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("last_status_change_at", s.LastStatusChangeAt.String); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set("ownership_role", s.OwnerName.String); err != nil {
 		return diag.FromErr(err)
 	}
@@ -43,6 +43,9 @@ func TestResourceConnectionAwsPrivatelinkCreate(t *testing.T) {
 		if err := connectionAwsPrivatelinkCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
+
+		r.Equal("principal", d.Get("principal"))
+		r.Equal("available", d.Get("status"))
 	})
 
 }
@@ -0,0 +1,106 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inAws = map[string]interface{}{
+	"name":                     "conn",
+	"schema_name":              "schema",
+	"database_name":            "database",
+	"region":                   "us-east-1",
+	"assume_role_arn":          "arn:aws:iam::000000000000:role/materialize",
+	"assume_role_session_name": "materialize",
+}
+
+func TestResourceConnectionAwsCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ConnectionAws().Schema, inAws)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE CONNECTION "database"."schema"."conn" TO AWS \(REGION = 'us-east-1', ASSUME ROLE ARN = 'arn:aws:iam::000000000000:role/materialize', ASSUME ROLE SESSION NAME = 'materialize'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_connections.name = 'conn' AND mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockConnectionScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionAwsScan(mock, pp)
+
+		if err := connectionAwsCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("arn:aws:iam::664411391173:role/MaterializeConnection", d.Get("principal"))
+		r.Equal("mz_00000000-0000-0000-0000-000000000000_u1", d.Get("external_id"))
+	})
+}
+
+func TestResourceConnectionAwsCreateAccessKey(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":              "conn",
+		"schema_name":       "schema",
+		"database_name":     "database",
+		"region":            "us-east-1",
+		"access_key_id":     []interface{}{map[string]interface{}{"secret": []interface{}{map[string]interface{}{"name": "access_key_id"}}}},
+		"secret_access_key": []interface{}{map[string]interface{}{"name": "secret_access_key"}},
+	}
+	d := schema.TestResourceDataRaw(t, ConnectionAws().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE CONNECTION "database"."schema"."conn" TO AWS \(REGION = 'us-east-1', ACCESS KEY ID = SECRET "database"."schema"."access_key_id", SECRET ACCESS KEY = SECRET "database"."schema"."secret_access_key"\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_connections.name = 'conn' AND mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockConnectionScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionAwsScan(mock, pp)
+
+		if err := connectionAwsCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceConnectionAwsUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ConnectionAws().Schema, inAws)
+
+	// Set current state
+	d.SetId("u1")
+	d.Set("name", "old_conn")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."" RENAME TO "conn";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionAwsScan(mock, pp)
+
+		if err := connectionAwsUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
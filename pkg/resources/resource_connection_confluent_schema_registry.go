@@ -22,24 +22,35 @@ var connectionConfluentSchemaRegistrySchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
 	},
-	"ssl_certificate_authority": ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Confluent Schema Registry.", false),
-	"ssl_certificate":           ValueSecretSchema("ssl_certificate", "The client certificate for the Confluent Schema Registry.", false),
-	"ssl_key":                   IdentifierSchema("ssl_key", "The client key for the Confluent Schema Registry.", false),
-	"password":                  IdentifierSchema("password", "The password for the Confluent Schema Registry.", false),
-	"username":                  ValueSecretSchema("username", "The username for the Confluent Schema Registry.", false),
-	"ssh_tunnel":                IdentifierSchema("ssh_tunnel", "The SSH tunnel configuration for the Confluent Schema Registry.", false),
-	"aws_privatelink":           IdentifierSchema("aws_privatelink", "The AWS PrivateLink configuration for the Confluent Schema Registry.", false),
+	"ssl_certificate_authority": alterableSchema(ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Confluent Schema Registry.", false)),
+	"ssl_certificate":           alterableSchema(ValueSecretSchema("ssl_certificate", "The client certificate for the Confluent Schema Registry.", false)),
+	"ssl_key":                   alterableSchema(IdentifierSchema("ssl_key", "The client key for the Confluent Schema Registry.", false)),
+	"password":                  alterableSchema(IdentifierSchema("password", "The password for the Confluent Schema Registry.", false)),
+	"username":                  alterableSchema(ValueSecretSchema("username", "The username for the Confluent Schema Registry.", false)),
+	"ssh_tunnel":                alterableSchema(IdentifierSchema("ssh_tunnel", "The SSH tunnel configuration for the Confluent Schema Registry.", false)),
+	"aws_privatelink":           alterableSchema(IdentifierSchema("aws_privatelink", "The AWS PrivateLink configuration for the Confluent Schema Registry.", false)),
 	"validate":                  ValidateConnectionSchema(),
 	"ownership_role":            OwnershipRoleSchema(),
 }
 
+var connectionConfluentSchemaRegistryOptions = []connectionOption{
+	{"url", "URL", stringOption("url")},
+	{"username", "USERNAME", valueSecretOption("username")},
+	{"password", "PASSWORD", secretOption("password")},
+	{"ssl_certificate_authority", "SSL CERTIFICATE AUTHORITY", valueSecretOption("ssl_certificate_authority")},
+	{"ssl_certificate", "SSL CERTIFICATE", valueSecretOption("ssl_certificate")},
+	{"ssl_key", "SSL KEY", secretOption("ssl_key")},
+	{"aws_privatelink", "AWS PRIVATELINK", identifierOption("aws_privatelink")},
+	{"ssh_tunnel", "SSH TUNNEL", identifierOption("ssh_tunnel")},
+}
+
 func ConnectionConfluentSchemaRegistry() *schema.Resource {
 	return &schema.Resource{
 		Description: "A Confluent Schema Registry connection establishes a link to a Confluent Schema Registry server.",
 
 		CreateContext: connectionConfluentSchemaRegistryCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionUpdate,
+		UpdateContext: connectionConfluentSchemaRegistryUpdate,
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -137,3 +148,7 @@ func connectionConfluentSchemaRegistryCreate(ctx context.Context, d *schema.Reso
 
 	return connectionRead(ctx, d, meta)
 }
+
+func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return connectionUpdateOptions(ctx, d, meta, connectionConfluentSchemaRegistryOptions)
+}
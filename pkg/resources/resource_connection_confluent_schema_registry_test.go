@@ -51,3 +51,32 @@ func TestResourceConnectionConfluentSchemaRegistryCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceConnectionConfluentSchemaRegistryUpdate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":          "conn",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"url":           "http://localhost:8082",
+		"password":      []interface{}{map[string]interface{}{"name": "password"}},
+	}
+	d := schema.TestResourceDataRaw(t, ConnectionConfluentSchemaRegistry().Schema, in)
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."" RENAME TO "conn";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."conn" SET \(URL = 'http://localhost:8082'\), SET \(PASSWORD = SECRET "database"."schema"."password"\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionScan(mock, pp)
+
+		if err := connectionConfluentSchemaRegistryUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
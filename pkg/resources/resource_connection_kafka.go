@@ -23,7 +23,6 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 		Type:        schema.TypeList,
 		Required:    true,
 		MinItems:    1,
-		ForceNew:    true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"broker": {
@@ -41,7 +40,7 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 					Type:        schema.TypeString,
 					Optional:    true,
 				},
-				"privatelink_connection": IdentifierSchema("privatelink_connection", "The AWS PrivateLink connection name in Materialize.", false),
+				"privatelink_connection": alterableSchema(IdentifierSchema("privatelink_connection", "The AWS PrivateLink connection name in Materialize.", false)),
 			},
 		},
 	},
@@ -51,31 +50,65 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 		Optional:    true,
 		ForceNew:    true,
 	},
-	"ssl_certificate_authority": ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Kafka broker.", false),
-	"ssl_certificate":           ValueSecretSchema("ssl_certificate", "The client certificate for the Kafka broker.", false),
-	"ssl_key":                   IdentifierSchema("ssl_key", "The client key for the Kafka broker.", false),
+	"ssl_certificate_authority": alterableSchema(ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Kafka broker.", false)),
+	"ssl_certificate":           alterableSchema(ValueSecretSchema("ssl_certificate", "The client certificate for the Kafka broker.", false)),
+	"ssl_key":                   alterableSchema(IdentifierSchema("ssl_key", "The client key for the Kafka broker.", false)),
 	"sasl_mechanisms": {
 		Description:  "The SASL mechanism for the Kafka broker.",
 		Type:         schema.TypeString,
 		Optional:     true,
 		ValidateFunc: validation.StringInSlice(saslMechanisms, true),
 		RequiredWith: []string{"sasl_username", "sasl_password"},
-		ForceNew:     true,
 	},
-	"sasl_username":  ValueSecretSchema("sasl_username", "The SASL username for the Kafka broker.", false),
-	"sasl_password":  IdentifierSchema("sasl_password", "The SASL password for the Kafka broker.", false),
-	"ssh_tunnel":     IdentifierSchema("ssh_tunnel", "The SSH tunnel configuration for the Kafka broker.", false),
+	"sasl_username":  alterableSchema(ValueSecretSchema("sasl_username", "The SASL username for the Kafka broker.", false)),
+	"sasl_password":  alterableSchema(IdentifierSchema("sasl_password", "The SASL password for the Kafka broker.", false)),
+	"ssh_tunnel":     alterableSchema(IdentifierSchema("ssh_tunnel", "The SSH tunnel configuration for the Kafka broker.", false)),
+	"aws_connection": kafkaAwsConnectionSchema(),
 	"validate":       ValidateConnectionSchema(),
 	"ownership_role": OwnershipRoleSchema(),
 }
 
+// Amazon MSK clusters using IAM access control authenticate through an AWS
+// connection instead of SASL credentials
+func kafkaAwsConnectionSchema() *schema.Schema {
+	s := alterableSchema(IdentifierSchema("aws_connection", "The AWS connection to use for IAM authentication with an Amazon MSK cluster.", false))
+	s.ConflictsWith = []string{"sasl_mechanisms", "sasl_username", "sasl_password"}
+	return s
+}
+
+// The SSH tunnel is set on each broker so changing it alters the brokers
+var connectionKafkaOptions = []connectionOption{
+	{"kafka_broker", "BROKERS", kafkaBrokersOption},
+	{"ssh_tunnel", "BROKERS", kafkaBrokersOption},
+	{"ssl_certificate_authority", "SSL CERTIFICATE AUTHORITY", valueSecretOption("ssl_certificate_authority")},
+	{"ssl_certificate", "SSL CERTIFICATE", valueSecretOption("ssl_certificate")},
+	{"ssl_key", "SSL KEY", secretOption("ssl_key")},
+	{"sasl_mechanisms", "SASL MECHANISMS", stringOption("sasl_mechanisms")},
+	{"sasl_username", "SASL USERNAME", valueSecretOption("sasl_username")},
+	{"sasl_password", "SASL PASSWORD", secretOption("sasl_password")},
+	{"aws_connection", "AWS CONNECTION", identifierOption("aws_connection")},
+}
+
+func kafkaBrokersOption(d *schema.ResourceData) string {
+	databaseName := d.Get("database_name").(string)
+	schemaName := d.Get("schema_name").(string)
+
+	brokers := materialize.GetKafkaBrokersStruct(databaseName, schemaName, d.Get("kafka_broker"))
+
+	var tunnel materialize.IdentifierSchemaStruct
+	if v, ok := d.GetOk("ssh_tunnel"); ok {
+		tunnel = materialize.GetIdentifierSchemaStruct(databaseName, schemaName, v)
+	}
+	return materialize.KafkaBrokersOptionValue(brokers, tunnel)
+}
+
 func ConnectionKafka() *schema.Resource {
 	return &schema.Resource{
 		Description: "A Kafka connection establishes a link to a Kafka cluster.",
 
 		CreateContext: connectionKafkaCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionUpdate,
+		UpdateContext: connectionKafkaUpdate,
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -137,6 +170,11 @@ func connectionKafkaCreate(ctx context.Context, d *schema.ResourceData, meta int
 		b.KafkaSSHTunnel(conn)
 	}
 
+	if v, ok := d.GetOk("aws_connection"); ok {
+		conn := materialize.GetIdentifierSchemaStruct(databaseName, schemaName, v)
+		b.KafkaAwsConnection(conn)
+	}
+
 	if v, ok := d.GetOk("validate"); ok {
 		b.Validate(v.(bool))
 	}
@@ -177,3 +215,7 @@ func connectionKafkaCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	return connectionRead(ctx, d, meta)
 }
+
+func connectionKafkaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return connectionUpdateOptions(ctx, d, meta, connectionKafkaOptions)
+}
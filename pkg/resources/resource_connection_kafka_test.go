@@ -53,3 +53,67 @@ func TestResourceConnectionKafkaCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceConnectionKafkaCreateAwsConnection(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":           "conn",
+		"schema_name":    "schema",
+		"database_name":  "database",
+		"kafka_broker":   []interface{}{map[string]interface{}{"broker": "b-1.msk.amazonaws.com:9098"}},
+		"aws_connection": []interface{}{map[string]interface{}{"name": "aws_conn"}},
+		"validate":       true,
+	}
+	d := schema.TestResourceDataRaw(t, ConnectionKafka().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE CONNECTION "database"."schema"."conn" TO KAFKA \(BROKERS \('b-1.msk.amazonaws.com:9098'\), AWS CONNECTION = "database"."schema"."aws_conn"\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_connections.name = 'conn' AND mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockConnectionScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionScan(mock, pp)
+
+		if err := connectionKafkaCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceConnectionKafkaUpdate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":          "conn",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"kafka_broker":  []interface{}{map[string]interface{}{"broker": "b-1.hostname-1:9096"}, map[string]interface{}{"broker": "b-2.hostname-2:9096"}},
+		"sasl_password": []interface{}{map[string]interface{}{"name": "password"}},
+		"validate":      false,
+	}
+	d := schema.TestResourceDataRaw(t, ConnectionKafka().Schema, in)
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."" RENAME TO "conn";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."conn" SET \(BROKERS = \('b-1.hostname-1:9096', 'b-2.hostname-2:9096'\)\), SET \(SASL PASSWORD = SECRET "database"."schema"."password"\) WITH \(VALIDATE = false\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionScan(mock, pp)
+
+		if err := connectionKafkaUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
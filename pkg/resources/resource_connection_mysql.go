@@ -0,0 +1,163 @@
+package resources
+
+import (
+	"context"
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
+)
+
+var connectionMysqlSchema = map[string]*schema.Schema{
+	"name":               ObjectNameSchema("connection", true, false),
+	"schema_name":        SchemaNameSchema("connection", false),
+	"database_name":      DatabaseNameSchema("connection", false),
+	"qualified_sql_name": QualifiedNameSchema("connection"),
+	"comment":            CommentSchema(false),
+	"host": {
+		Description: "The MySQL database hostname.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"port": {
+		Description: "The MySQL database port.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     3306,
+		ForceNew:    true,
+	},
+	"user":                      ValueSecretSchema("user", "The MySQL database username.", true),
+	"password":                  IdentifierSchema("password", "The MySQL database password.", false),
+	"ssh_tunnel":                IdentifierSchema("ssh_tunnel", "The SSH tunnel configuration for the MySQL database.", false),
+	"ssl_certificate_authority": ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the MySQL database.", false),
+	"ssl_certificate":           ValueSecretSchema("ssl_certificate", "The client certificate for the MySQL database.", false),
+	"ssl_key":                   IdentifierSchema("ssl_key", "The client key for the MySQL database.", false),
+	"ssl_mode": {
+		Description:  "The SSL mode for the MySQL database.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(mysqlSSLModes, true),
+	},
+	"aws_privatelink": IdentifierSchema("aws_privatelink", "The AWS PrivateLink configuration for the MySQL database.", false),
+	"validate":        ValidateConnectionSchema(),
+	"ownership_role":  OwnershipRoleSchema(),
+}
+
+func ConnectionMysql() *schema.Resource {
+	return &schema.Resource{
+		Description: "A MySQL connection establishes a link to a single MySQL server.",
+
+		CreateContext: connectionMysqlCreate,
+		ReadContext:   connectionRead,
+		UpdateContext: connectionUpdate,
+		DeleteContext: connectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: connectionMysqlSchema,
+	}
+}
+
+func connectionMysqlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "CONNECTION", Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewConnectionMysqlBuilder(meta.(*sqlx.DB), o)
+
+	if v, ok := d.GetOk("host"); ok {
+		b.MysqlHost(v.(string))
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		b.MysqlPort(v.(int))
+	}
+
+	if v, ok := d.GetOk("user"); ok {
+		user := materialize.GetValueSecretStruct(databaseName, schemaName, v)
+		b.MysqlUser(user)
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		pass := materialize.GetIdentifierSchemaStruct(databaseName, schemaName, v)
+		b.MysqlPassword(pass)
+	}
+
+	if v, ok := d.GetOk("ssl_mode"); ok {
+		b.MysqlSSLMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("ssl_certificate_authority"); ok {
+		ssl_ca := materialize.GetValueSecretStruct(databaseName, schemaName, v)
+		b.MysqlSSLCa(ssl_ca)
+	}
+
+	if v, ok := d.GetOk("ssl_certificate"); ok {
+		ssl_cert := materialize.GetValueSecretStruct(databaseName, schemaName, v)
+		b.MysqlSSLCert(ssl_cert)
+	}
+
+	if v, ok := d.GetOk("ssl_key"); ok {
+		k := materialize.GetIdentifierSchemaStruct(databaseName, schemaName, v)
+		b.MysqlSSLKey(k)
+	}
+
+	if v, ok := d.GetOk("aws_privatelink"); ok {
+		conn := materialize.GetIdentifierSchemaStruct(databaseName, schemaName, v)
+		b.MysqlAWSPrivateLink(conn)
+	}
+
+	if v, ok := d.GetOk("ssh_tunnel"); ok {
+		conn := materialize.GetIdentifierSchemaStruct(databaseName, schemaName, v)
+		b.MysqlSSHTunnel(conn)
+	}
+
+	if v, ok := d.GetOk("validate"); ok {
+		b.Validate(v.(bool))
+	}
+
+	// create resource
+	if err := b.Create(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// ownership
+	if v, ok := d.GetOk("ownership_role"); ok {
+		ownership := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
+
+		if err := ownership.Alter(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed ownership, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// object comment
+	if v, ok := d.GetOk("comment"); ok {
+		comment := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+		if err := comment.Object(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// set id
+	i, err := materialize.ConnectionId(meta.(*sqlx.DB), o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(i)
+
+	return connectionRead(ctx, d, meta)
+}
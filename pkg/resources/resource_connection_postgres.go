@@ -21,45 +21,55 @@ var connectionPostgresSchema = map[string]*schema.Schema{
 		Description: "The target Postgres database.",
 		Type:        schema.TypeString,
 		Required:    true,
-		ForceNew:    true,
 	},
 	"host": {
 		Description: "The Postgres database hostname.",
 		Type:        schema.TypeString,
 		Required:    true,
-		ForceNew:    true,
 	},
 	"port": {
 		Description: "The Postgres database port.",
 		Type:        schema.TypeInt,
 		Optional:    true,
 		Default:     5432,
-		ForceNew:    true,
 	},
-	"user":                      ValueSecretSchema("user", "The Postgres database username.", true),
-	"password":                  IdentifierSchema("password", "The Postgres database password.", false),
-	"ssh_tunnel":                IdentifierSchema("ssh_tunnel", "The SSH tunnel configuration for the Postgres database.", false),
-	"ssl_certificate_authority": ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Postgres database.", false),
-	"ssl_certificate":           ValueSecretSchema("ssl_certificate", "The client certificate for the Postgres database.", false),
-	"ssl_key":                   IdentifierSchema("ssl_key", "The client key for the Postgres database.", false),
+	"user":                      alterableSchema(ValueSecretSchema("user", "The Postgres database username.", true)),
+	"password":                  alterableSchema(IdentifierSchema("password", "The Postgres database password.", false)),
+	"ssh_tunnel":                alterableSchema(IdentifierSchema("ssh_tunnel", "The SSH tunnel configuration for the Postgres database.", false)),
+	"ssl_certificate_authority": alterableSchema(ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Postgres database.", false)),
+	"ssl_certificate":           alterableSchema(ValueSecretSchema("ssl_certificate", "The client certificate for the Postgres database.", false)),
+	"ssl_key":                   alterableSchema(IdentifierSchema("ssl_key", "The client key for the Postgres database.", false)),
 	"ssl_mode": {
 		Description: "The SSL mode for the Postgres database.",
 		Type:        schema.TypeString,
 		Optional:    true,
-		ForceNew:    true,
 	},
-	"aws_privatelink": IdentifierSchema("aws_privatelink", "The AWS PrivateLink configuration for the Postgres database.", false),
+	"aws_privatelink": alterableSchema(IdentifierSchema("aws_privatelink", "The AWS PrivateLink configuration for the Postgres database.", false)),
 	"validate":        ValidateConnectionSchema(),
 	"ownership_role":  OwnershipRoleSchema(),
 }
 
+var connectionPostgresOptions = []connectionOption{
+	{"host", "HOST", stringOption("host")},
+	{"port", "PORT", intOption("port")},
+	{"database", "DATABASE", stringOption("database")},
+	{"user", "USER", valueSecretOption("user")},
+	{"password", "PASSWORD", secretOption("password")},
+	{"ssl_mode", "SSL MODE", stringOption("ssl_mode")},
+	{"ssh_tunnel", "SSH TUNNEL", identifierOption("ssh_tunnel")},
+	{"ssl_certificate_authority", "SSL CERTIFICATE AUTHORITY", valueSecretOption("ssl_certificate_authority")},
+	{"ssl_certificate", "SSL CERTIFICATE", valueSecretOption("ssl_certificate")},
+	{"ssl_key", "SSL KEY", secretOption("ssl_key")},
+	{"aws_privatelink", "AWS PRIVATELINK", identifierOption("aws_privatelink")},
+}
+
 func ConnectionPostgres() *schema.Resource {
 	return &schema.Resource{
 		Description: "A Postgres connection establishes a link to a single database of a PostgreSQL server.",
 
 		CreateContext: connectionPostgresCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionUpdate,
+		UpdateContext: connectionPostgresUpdate,
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -173,3 +183,7 @@ func connectionPostgresCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	return connectionRead(ctx, d, meta)
 }
+
+func connectionPostgresUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return connectionUpdateOptions(ctx, d, meta, connectionPostgresOptions)
+}
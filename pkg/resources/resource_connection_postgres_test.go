@@ -53,3 +53,25 @@ func TestResourceConnectionPostgresCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceConnectionPostgresUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ConnectionPostgres().Schema, inPostgres)
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."" RENAME TO "conn";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."conn" SET \(HOST = 'postgres_host'\), SET \(PORT = 5432\), SET \(DATABASE = 'default'\), SET \(USER = SECRET "database"."schema"."user"\), SET \(PASSWORD = SECRET "database"."schema"."password"\), SET \(SSL MODE = 'verify-full'\), SET \(SSH TUNNEL = "database"."schema"."ssh_conn"\), SET \(SSL CERTIFICATE AUTHORITY = SECRET "database"."schema"."root"\), SET \(SSL CERTIFICATE = SECRET "database"."schema"."cert"\), SET \(SSL KEY = SECRET "database"."schema"."key"\), SET \(AWS PRIVATELINK = "database"."schema"."link"\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionScan(mock, pp)
+
+		if err := connectionPostgresUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
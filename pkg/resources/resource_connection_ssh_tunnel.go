@@ -22,19 +22,16 @@ var connectionSshTunnelSchema = map[string]*schema.Schema{
 		Description: "The host of the SSH tunnel.",
 		Type:        schema.TypeString,
 		Required:    true,
-		ForceNew:    true,
 	},
 	"user": {
 		Description: "The user of the SSH tunnel.",
 		Type:        schema.TypeString,
 		Required:    true,
-		ForceNew:    true,
 	},
 	"port": {
 		Description: "The port of the SSH tunnel.",
 		Type:        schema.TypeInt,
 		Required:    true,
-		ForceNew:    true,
 	},
 	"public_key_1": {
 		Description: "The first public key associated with the SSH tunnel.",
@@ -46,9 +43,20 @@ var connectionSshTunnelSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Computed:    true,
 	},
+	"rotate_keys_trigger": {
+		Description: "An arbitrary value that rotates the key pairs of the SSH tunnel with `ALTER CONNECTION ... ROTATE KEYS` whenever it changes, such as a timestamp. Rotation moves the current primary key to `public_key_2` and generates a new `public_key_1`, so both keys should be authorized on the bastion server.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
 	"ownership_role": OwnershipRoleSchema(),
 }
 
+var connectionSshTunnelOptions = []connectionOption{
+	{"host", "HOST", stringOption("host")},
+	{"user", "USER", stringOption("user")},
+	{"port", "PORT", intOption("port")},
+}
+
 func ConnectionSshTunnel() *schema.Resource {
 	return &schema.Resource{
 		Description: "An SSH tunnel connection establishes a link to an SSH bastion server.",
@@ -58,6 +66,8 @@ func ConnectionSshTunnel() *schema.Resource {
 		UpdateContext: connectionSshTunnelUpdate,
 		DeleteContext: connectionDelete,
 
+		CustomizeDiff: connectionSshTunnelCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -180,6 +190,10 @@ func connectionSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if err := connectionAlter(d, meta, connectionSshTunnelOptions); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
 		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
@@ -188,6 +202,13 @@ func connectionSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("rotate_keys_trigger") {
+		b := materialize.NewConnectionSshTunnelBuilder(meta.(*sqlx.DB), o)
+		if err := b.RotateKeys(); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
 		b := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
@@ -199,3 +220,16 @@ func connectionSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	return connectionSshTunnelRead(ctx, d, meta)
 }
+
+// Rotating the keys changes both public keys, mark them as unknown so the plan
+// does not promise the current values
+func connectionSshTunnelCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("rotate_keys_trigger") {
+		return nil
+	}
+
+	if err := d.SetNewComputed("public_key_1"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("public_key_2")
+}
@@ -59,6 +59,7 @@ func TestResourceConnectionSshTunnelUpdate(t *testing.T) {
 
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."" RENAME TO "conn";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."old_conn" SET \(HOST = 'localhost'\), SET \(USER = 'user'\), SET \(PORT = 123\);`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		// Query Params
 		pp := `WHERE mz_connections.id = 'u1'`
@@ -69,3 +70,36 @@ func TestResourceConnectionSshTunnelUpdate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceConnectionSshTunnelRotateKeys(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":                "conn",
+		"schema_name":         "schema",
+		"database_name":       "database",
+		"host":                "localhost",
+		"port":                123,
+		"user":                "user",
+		"rotate_keys_trigger": "2024-01-01",
+	}
+	d := schema.TestResourceDataRaw(t, ConnectionSshTunnel().Schema, in)
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."" RENAME TO "conn";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."conn" SET \(HOST = 'localhost'\), SET \(USER = 'user'\), SET \(PORT = 123\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."conn" ROTATE KEYS;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionSshTunnelScan(mock, pp)
+
+		if err := connectionSshTunnelUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("key_1", d.Get("public_key_1"))
+		r.Equal("key_2", d.Get("public_key_2"))
+	})
+}
@@ -0,0 +1,332 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var deploymentSchema = map[string]*schema.Schema{
+	"schema_name": {
+		Description: "The production schema that is swapped with the shadow schema on promotion.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"database_name": DatabaseNameSchema("deployment", false),
+	"cluster_name": {
+		Description: "The production cluster that is swapped with the shadow cluster on promotion.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"shadow_schema_name": {
+		Description: "The shadow schema to deploy new objects into. Defaults to `<schema_name>_deploy`.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+	},
+	"shadow_cluster_name": {
+		Description: "The shadow cluster to deploy new objects into. It is created with the same size, replication factor and disk settings as the production cluster. Defaults to `<cluster_name>_deploy`.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+	},
+	"promote": {
+		Description: "Set to `true` once the new objects have been created in the shadow schema and cluster. The provider waits until every object on the shadow cluster is hydrated and then swaps the shadow schema and cluster with the production schema and cluster in a single transaction. Setting it back to `false` drops the previous production objects, which now live under the shadow names, and recreates an empty shadow schema and cluster for the next rollout.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+}
+
+func Deployment() *schema.Resource {
+	return &schema.Resource{
+		Description: "A blue/green deployment that builds a shadow copy of a schema and cluster and atomically swaps them into production once hydrated.",
+
+		CreateContext: deploymentCreate,
+		ReadContext:   deploymentRead,
+		UpdateContext: deploymentUpdate,
+		DeleteContext: deploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: deploymentImport,
+		},
+
+		CustomizeDiff: deploymentCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: deploymentSchema,
+	}
+}
+
+type DeploymentKey struct {
+	databaseName string
+	schemaName   string
+	clusterName  string
+}
+
+func parseDeploymentKey(id string) (DeploymentKey, error) {
+	ie := strings.Split(id, "|")
+
+	if len(ie) != 3 {
+		return DeploymentKey{}, fmt.Errorf("%s cannot be parsed correctly", id)
+	}
+
+	return DeploymentKey{
+		databaseName: ie[0],
+		schemaName:   ie[1],
+		clusterName:  ie[2],
+	}, nil
+}
+
+// Deployments are imported by the production database, schema and cluster,
+// followed by the shadow schema and cluster when they do not use the default
+// names. An imported deployment is not promoted
+func deploymentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ie := strings.Split(d.Id(), "|")
+
+	if len(ie) != 3 && len(ie) != 5 {
+		return nil, fmt.Errorf("%s cannot be parsed correctly, expected <database>|<schema>|<cluster>[|<shadow_schema>|<shadow_cluster>]", d.Id())
+	}
+
+	shadowSchemaName := fmt.Sprintf("%s_deploy", ie[1])
+	shadowClusterName := fmt.Sprintf("%s_deploy", ie[2])
+	if len(ie) == 5 {
+		shadowSchemaName = ie[3]
+		shadowClusterName = ie[4]
+	}
+
+	if err := d.Set("shadow_schema_name", shadowSchemaName); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("shadow_cluster_name", shadowClusterName); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("promote", false); err != nil {
+		return nil, err
+	}
+
+	d.SetId(strings.Join(ie[:3], "|"))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func deploymentBuilder(d *schema.ResourceData, meta interface{}) *materialize.DeploymentBuilder {
+	o := materialize.MaterializeObject{Name: d.Get("schema_name").(string), DatabaseName: d.Get("database_name").(string)}
+	b := materialize.NewDeploymentBuilder(meta.(*sqlx.DB), o)
+	b.ClusterName(d.Get("cluster_name").(string))
+	b.ShadowSchemaName(d.Get("shadow_schema_name").(string))
+	b.ShadowClusterName(d.Get("shadow_cluster_name").(string))
+	return b
+}
+
+func deploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	key, err := parseDeploymentKey(i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	databaseName := key.databaseName
+	shadowSchemaName := d.Get("shadow_schema_name").(string)
+	shadowClusterName := d.Get("shadow_cluster_name").(string)
+
+	// The production and shadow objects must all exist for the deployment to exist
+	for _, o := range []materialize.MaterializeObject{
+		{ObjectType: "SCHEMA", Name: key.schemaName, DatabaseName: databaseName},
+		{ObjectType: "SCHEMA", Name: shadowSchemaName, DatabaseName: databaseName},
+		{ObjectType: "CLUSTER", Name: key.clusterName},
+		{ObjectType: "CLUSTER", Name: shadowClusterName},
+	} {
+		var err error
+		if o.ObjectType == "SCHEMA" {
+			_, err = materialize.SchemaId(meta.(*sqlx.DB), o)
+		} else {
+			_, err = materialize.ClusterId(meta.(*sqlx.DB), o)
+		}
+
+		if err == sql.ErrNoRows {
+			log.Printf("[DEBUG] deployment %s: %s %s not found", i, strings.ToLower(o.ObjectType), o.Name)
+			d.SetId("")
+			return nil
+		} else if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(i)
+
+	if err := d.Set("database_name", key.databaseName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("schema_name", key.schemaName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("cluster_name", key.clusterName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func deploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	if _, ok := d.GetOk("shadow_schema_name"); !ok {
+		d.Set("shadow_schema_name", fmt.Sprintf("%s_deploy", schemaName))
+	}
+
+	if _, ok := d.GetOk("shadow_cluster_name"); !ok {
+		d.Set("shadow_cluster_name", fmt.Sprintf("%s_deploy", clusterName))
+	}
+
+	if err := deploymentInit(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{databaseName, schemaName, clusterName}, "|"))
+
+	return deploymentRead(ctx, d, meta)
+}
+
+// Creates the shadow schema and a shadow cluster mirroring the production cluster
+func deploymentInit(d *schema.ResourceData, meta interface{}) error {
+	databaseName := d.Get("database_name").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	ci, err := materialize.ClusterId(meta.(*sqlx.DB), materialize.MaterializeObject{Name: clusterName})
+	if err != nil {
+		return fmt.Errorf("unable to find production cluster %s: %w", clusterName, err)
+	}
+
+	c, err := materialize.ScanCluster(meta.(*sqlx.DB), ci)
+	if err != nil {
+		return err
+	}
+
+	co := materialize.MaterializeObject{ObjectType: "CLUSTER", Name: d.Get("shadow_cluster_name").(string)}
+	cb := materialize.NewClusterBuilder(meta.(*sqlx.DB), co)
+
+	if c.Managed.Bool && c.Size.String != "" {
+		cb.Size(c.Size.String)
+		cb.ReplicationFactor(int(c.ReplicationFactor.Int64))
+		cb.Disk(c.Disk.Bool)
+	}
+
+	if err := cb.Create(); err != nil {
+		return err
+	}
+
+	so := materialize.MaterializeObject{ObjectType: "SCHEMA", Name: d.Get("shadow_schema_name").(string), DatabaseName: databaseName}
+	sb := materialize.NewSchemaBuilder(meta.(*sqlx.DB), so)
+
+	if err := sb.Create(); err != nil {
+		log.Printf("[DEBUG] resource failed schema, dropping cluster: %s", co.Name)
+		cb.Drop()
+		return err
+	}
+
+	return nil
+}
+
+func deploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("promote") {
+		b := deploymentBuilder(d, meta)
+
+		if d.Get("promote").(bool) {
+			shadowClusterName := d.Get("shadow_cluster_name").(string)
+			if err := deploymentWaitHydrated(ctx, meta.(*sqlx.DB), shadowClusterName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err := b.Promote(); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := b.DropShadow(); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err := deploymentInit(d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return deploymentRead(ctx, d, meta)
+}
+
+func deploymentWaitHydrated(ctx context.Context, conn *sqlx.DB, clusterName string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		u, err := materialize.ListUnhydratedObjects(conn, clusterName)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if len(u) > 0 {
+			var o []string
+			for _, h := range u {
+				o = append(o, fmt.Sprintf("%s (replica %s)", h.ObjectName.String, h.ReplicaName.String))
+			}
+			return retry.RetryableError(fmt.Errorf("waiting for objects on cluster %s to hydrate: %s", clusterName, strings.Join(o, ", ")))
+		}
+
+		return nil
+	})
+}
+
+func deploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deploymentBuilder(d, meta).DropShadow(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func deploymentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	if d.Get("promote").(bool) {
+		return fmt.Errorf("promote must be false when the deployment is created, set it to true once objects have been deployed to the shadow schema and cluster")
+	}
+
+	// Resolve the default shadow names at plan time so dependent objects can reference them
+	if d.Get("shadow_schema_name").(string) == "" && d.NewValueKnown("schema_name") {
+		if err := d.SetNew("shadow_schema_name", fmt.Sprintf("%s_deploy", d.Get("schema_name").(string))); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("shadow_cluster_name").(string) == "" && d.NewValueKnown("cluster_name") {
+		if err := d.SetNew("shadow_cluster_name", fmt.Sprintf("%s_deploy", d.Get("cluster_name").(string))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
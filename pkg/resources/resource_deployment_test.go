@@ -0,0 +1,152 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inDeployment = map[string]interface{}{
+	"schema_name":   "schema",
+	"database_name": "database",
+	"cluster_name":  "cluster",
+}
+
+func mockDeploymentRead(mock sqlmock.Sqlmock) {
+	testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema'`)
+	testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema_deploy'`)
+	testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'cluster'`)
+	testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'cluster_deploy'`)
+}
+
+func TestResourceDeploymentCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Deployment().Schema, inDeployment)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Query production cluster
+		testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'cluster'`)
+		testhelpers.MockClusterScan(mock, `WHERE mz_clusters.id = 'u1'`)
+
+		// Create shadow objects
+		mock.ExpectExec(`CREATE CLUSTER "cluster_deploy" SIZE 'small', DISK, REPLICATION FACTOR 2;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`CREATE SCHEMA "database"."schema_deploy";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		mockDeploymentRead(mock)
+
+		if err := deploymentCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("database|schema|cluster", d.Id())
+		r.Equal("schema_deploy", d.Get("shadow_schema_name"))
+		r.Equal("cluster_deploy", d.Get("shadow_cluster_name"))
+	})
+}
+
+var inDeploymentPromote = map[string]interface{}{
+	"schema_name":         "schema",
+	"database_name":       "database",
+	"cluster_name":        "cluster",
+	"shadow_schema_name":  "schema_deploy",
+	"shadow_cluster_name": "cluster_deploy",
+	"promote":             true,
+}
+
+func TestResourceDeploymentPromote(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Deployment().Schema, inDeploymentPromote)
+	r.NotNil(d)
+
+	d.SetId("database|schema|cluster")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Wait for hydration
+		testhelpers.MockHydrationScan(mock, `WHERE mz_clusters.name = 'cluster_deploy'`, true)
+
+		// Swap
+		mock.ExpectBegin()
+		mock.ExpectExec(`ALTER SCHEMA "database"."schema" SWAP WITH "schema_deploy";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SWAP WITH "cluster_deploy";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		// Query Params
+		mockDeploymentRead(mock)
+
+		if err := deploymentUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceDeploymentDelete(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Deployment().Schema, inDeployment)
+	r.NotNil(d)
+
+	d.SetId("database|schema|cluster")
+	d.Set("shadow_schema_name", "schema_deploy")
+	d.Set("shadow_cluster_name", "cluster_deploy")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`DROP SCHEMA IF EXISTS "database"."schema_deploy" CASCADE;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`DROP CLUSTER IF EXISTS "cluster_deploy" CASCADE;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := deploymentDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceDeploymentImport(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, Deployment().Schema, map[string]interface{}{})
+	d.SetId("database|schema|cluster")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		s, err := deploymentImport(context.TODO(), d, db)
+		r.NoError(err)
+		r.Len(s, 1)
+
+		// Query Params
+		mockDeploymentRead(mock)
+
+		if err := deploymentRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("database|schema|cluster", d.Id())
+		r.Equal("database", d.Get("database_name"))
+		r.Equal("schema", d.Get("schema_name"))
+		r.Equal("cluster", d.Get("cluster_name"))
+		r.Equal("schema_deploy", d.Get("shadow_schema_name"))
+		r.Equal("cluster_deploy", d.Get("shadow_cluster_name"))
+	})
+}
+
+func TestResourceDeploymentImportShadowNames(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, Deployment().Schema, map[string]interface{}{})
+	d.SetId("database|schema|cluster|schema_green|cluster_green")
+
+	_, err := deploymentImport(context.TODO(), d, nil)
+	r.NoError(err)
+
+	r.Equal("database|schema|cluster", d.Id())
+	r.Equal("schema_green", d.Get("shadow_schema_name"))
+	r.Equal("cluster_green", d.Get("shadow_cluster_name"))
+
+	d.SetId("database|schema")
+	_, err = deploymentImport(context.TODO(), d, nil)
+	r.Error(err)
+}
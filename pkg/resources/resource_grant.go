@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
@@ -17,6 +18,7 @@ type GrantPrivilegeKey struct {
 	objectType string
 	objectId   string
 	roleId     string
+	privilege  string
 }
 
 func parsePrivilegeKey(id string) (GrantPrivilegeKey, error) {
@@ -30,6 +32,7 @@ func parsePrivilegeKey(id string) (GrantPrivilegeKey, error) {
 		objectType: ie[1],
 		objectId:   ie[2],
 		roleId:     ie[3],
+		privilege:  ie[4],
 	}, nil
 }
 
@@ -43,8 +46,23 @@ func grantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 
 	privileges, err := materialize.ScanPrivileges(meta.(*sqlx.DB), key.objectType, key.objectId)
 	if err == sql.ErrNoRows {
-		d.SetId("")
-		return nil
+		objectId, err := grantReplacedObjectId(meta.(*sqlx.DB), key.objectType, d)
+		if err == sql.ErrNoRows {
+			d.SetId("")
+			return nil
+		} else if err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[DEBUG] %s: object was replaced, id changed to %s", i, objectId)
+		key.objectId = objectId
+		i = fmt.Sprintf("GRANT|%s|%s|%s|%s", key.objectType, key.objectId, key.roleId, key.privilege)
+		d.SetId(i)
+
+		privileges, err = materialize.ScanPrivileges(meta.(*sqlx.DB), key.objectType, key.objectId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	} else if err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,3 +78,145 @@ func grantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 
 	return nil
 }
+
+// Objects that are replaced in place and get a new id. The attribute holds the
+// name of the object in the grant resource
+var grantReplacedObjects = map[string]string{
+	"VIEW":              "view_name",
+	"MATERIALIZED VIEW": "materialized_view_name",
+}
+
+// Looks up an object that was replaced by the name in the grant configuration
+// so the grant follows the new object instead of being recreated
+func grantReplacedObjectId(conn *sqlx.DB, objectType string, d *schema.ResourceData) (string, error) {
+	attribute, ok := grantReplacedObjects[objectType]
+	if !ok {
+		return "", sql.ErrNoRows
+	}
+
+	o := materialize.MaterializeObject{
+		ObjectType:   objectType,
+		Name:         d.Get(attribute).(string),
+		SchemaName:   d.Get("schema_name").(string),
+		DatabaseName: d.Get("database_name").(string),
+	}
+	return materialize.ObjectId(conn, o)
+}
+
+// The attributes that identify the object of a grant
+func grantObjectAttributes(conn *sqlx.DB, objectType, objectId string) (map[string]string, error) {
+	switch objectType {
+	case "DATABASE":
+		p, err := materialize.ScanDatabase(conn, objectId)
+		return map[string]string{"database_name": p.DatabaseName.String}, err
+
+	case "SCHEMA":
+		p, err := materialize.ScanSchema(conn, objectId)
+		return map[string]string{"schema_name": p.SchemaName.String, "database_name": p.DatabaseName.String}, err
+
+	case "CLUSTER":
+		p, err := materialize.ScanCluster(conn, objectId)
+		return map[string]string{"cluster_name": p.ClusterName.String}, err
+
+	case "TABLE":
+		p, err := materialize.ScanTable(conn, objectId)
+		return map[string]string{"table_name": p.TableName.String, "schema_name": p.SchemaName.String, "database_name": p.DatabaseName.String}, err
+
+	case "VIEW":
+		p, err := materialize.ScanView(conn, objectId)
+		return map[string]string{"view_name": p.ViewName.String, "schema_name": p.SchemaName.String, "database_name": p.DatabaseName.String}, err
+
+	case "MATERIALIZED VIEW":
+		p, err := materialize.ScanMaterializedView(conn, objectId)
+		return map[string]string{"materialized_view_name": p.MaterializedViewName.String, "schema_name": p.SchemaName.String, "database_name": p.DatabaseName.String}, err
+
+	case "TYPE":
+		p, err := materialize.ScanType(conn, objectId)
+		return map[string]string{"type_name": p.TypeName.String, "schema_name": p.SchemaName.String, "database_name": p.DatabaseName.String}, err
+
+	case "SOURCE":
+		p, err := materialize.ScanSource(conn, objectId)
+		return map[string]string{"source_name": p.SourceName.String, "schema_name": p.SchemaName.String, "database_name": p.DatabaseName.String}, err
+
+	case "CONNECTION":
+		p, err := materialize.ScanConnection(conn, objectId)
+		return map[string]string{"connection_name": p.ConnectionName.String, "schema_name": p.SchemaName.String, "database_name": p.DatabaseName.String}, err
+
+	case "SECRET":
+		p, err := materialize.ScanSecret(conn, objectId)
+		return map[string]string{"secret_name": p.SecretName.String, "schema_name": p.SchemaName.String, "database_name": p.DatabaseName.String}, err
+	}
+
+	return nil, fmt.Errorf("grants on %s cannot be imported", objectType)
+}
+
+// Sets the role, privilege and object from the grant id so imported grants
+// match their configuration
+func grantImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	key, err := parsePrivilegeKey(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	roleName := "PUBLIC"
+	if key.roleId != "p" {
+		r, err := materialize.ScanRole(meta.(*sqlx.DB), key.roleId)
+		if err != nil {
+			return nil, err
+		}
+		roleName = r.RoleName.String
+	}
+
+	if err := d.Set("role_name", roleName); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("privilege", key.privilege); err != nil {
+		return nil, err
+	}
+
+	attributes, err := grantObjectAttributes(meta.(*sqlx.DB), key.objectType, key.objectId)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Grants the privileges of an object that was replaced to the new object
+func restorePrivileges(conn *sqlx.DB, privileges string, o materialize.MaterializeObject) error {
+	if privileges == "" {
+		return nil
+	}
+
+	privilegeMap := materialize.ParsePrivileges(privileges)
+
+	var roleIds []string
+	for roleId := range privilegeMap {
+		if roleId != "" {
+			roleIds = append(roleIds, roleId)
+		}
+	}
+	sort.Strings(roleIds)
+
+	for _, roleId := range roleIds {
+		r, err := materialize.ScanRole(conn, roleId)
+		if err != nil {
+			return err
+		}
+
+		for _, privilege := range privilegeMap[roleId] {
+			if err := materialize.NewPrivilegeBuilder(conn, r.RoleName.String, privilege, o).Grant(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
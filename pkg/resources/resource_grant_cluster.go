@@ -30,7 +30,7 @@ func GrantCluster() *schema.Resource {
 		DeleteContext: grantClusterDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: grantImport,
 		},
 
 		Schema: grantClusterSchema,
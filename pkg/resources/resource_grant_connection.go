@@ -42,7 +42,7 @@ func GrantConnection() *schema.Resource {
 		DeleteContext: grantConnectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: grantImport,
 		},
 
 		Schema: grantConnectionSchema,
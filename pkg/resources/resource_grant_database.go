@@ -30,7 +30,7 @@ func GrantDatabase() *schema.Resource {
 		DeleteContext: grantDatabaseDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: grantImport,
 		},
 
 		Schema: grantDatabaseSchema,
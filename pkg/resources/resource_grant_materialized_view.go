@@ -42,7 +42,7 @@ func GrantMaterializedView() *schema.Resource {
 		DeleteContext: grantMaterializedViewDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: grantImport,
 		},
 
 		Schema: grantMaterializedViewSchema,
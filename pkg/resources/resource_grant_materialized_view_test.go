@@ -73,3 +73,36 @@ func TestResourceGrantMaterializedViewDelete(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceGrantMaterializedViewReadReplaced(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"role_name":              "joe",
+		"privilege":              "SELECT",
+		"materialized_view_name": "mview",
+		"schema_name":            "schema",
+		"database_name":          "database",
+	}
+	d := schema.TestResourceDataRaw(t, GrantMaterializedView().Schema, in)
+	r.NotNil(d)
+	d.SetId("GRANT|MATERIALIZED VIEW|u2|u1|SELECT")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Previous materialized view id no longer exists
+		mock.ExpectQuery(`SELECT .* WHERE mz_materialized_views.id = \$1;`).WithArgs("u2").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		// Query moved materialized view by name
+		ip := `WHERE mz_databases.name = 'database' AND mz_materialized_views.name = 'mview' AND mz_schemas.name = 'schema'`
+		testhelpers.MockMaterializeViewScan(mock, ip)
+
+		// Query Params
+		testhelpers.MockMaterializeViewScan(mock, `WHERE mz_materialized_views.id = 'u1'`)
+
+		if err := grantRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("GRANT|MATERIALIZED VIEW|u1|u1|SELECT", d.Id())
+	})
+}
@@ -36,7 +36,7 @@ func GrantSchema() *schema.Resource {
 		DeleteContext: grantSchemaDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: grantImport,
 		},
 
 		Schema: grantSchemaSchema,
@@ -42,7 +42,7 @@ func GrantSecret() *schema.Resource {
 		DeleteContext: grantSecretDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: grantImport,
 		},
 
 		Schema: grantSecretSchema,
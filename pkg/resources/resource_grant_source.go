@@ -42,7 +42,7 @@ func GrantSource() *schema.Resource {
 		DeleteContext: grantSourceDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: grantImport,
 		},
 
 		Schema: grantSourceSchema,
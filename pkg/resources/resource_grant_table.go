@@ -42,7 +42,7 @@ func GrantTable() *schema.Resource {
 		DeleteContext: grantTableDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: grantImport,
 		},
 
 		Schema: grantTableSchema,
@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceGrantImport(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, GrantTable().Schema, map[string]interface{}{})
+	d.SetId("GRANT|TABLE|u1|u1|INSERT")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Query Role
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+
+		// Query Object
+		testhelpers.MockTableScan(mock, `WHERE mz_tables.id = 'u1'`)
+
+		s, err := grantImport(context.TODO(), d, db)
+		r.NoError(err)
+		r.Len(s, 1)
+
+		r.Equal("joe", d.Get("role_name"))
+		r.Equal("INSERT", d.Get("privilege"))
+		r.Equal("table", d.Get("table_name"))
+		r.Equal("schema", d.Get("schema_name"))
+		r.Equal("database", d.Get("database_name"))
+	})
+}
+
+func TestResourceGrantImportPublic(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, GrantDatabase().Schema, map[string]interface{}{})
+	d.SetId("GRANT|DATABASE|u1|p|USAGE")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Query Object
+		testhelpers.MockDatabaseScan(mock, `WHERE mz_databases.id = 'u1'`)
+
+		_, err := grantImport(context.TODO(), d, db)
+		r.NoError(err)
+
+		r.Equal("PUBLIC", d.Get("role_name"))
+		r.Equal("USAGE", d.Get("privilege"))
+		r.Equal("database", d.Get("database_name"))
+	})
+}
+
+func TestResourceGrantImportInvalidId(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, GrantDatabase().Schema, map[string]interface{}{})
+	d.SetId("u1")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		_, err := grantImport(context.TODO(), d, db)
+		r.Error(err)
+	})
+}
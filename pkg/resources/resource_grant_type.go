@@ -42,7 +42,7 @@ func GrantType() *schema.Resource {
 		DeleteContext: grantTypeDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: grantImport,
 		},
 
 		Schema: grantTypeSchema,
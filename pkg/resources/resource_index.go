@@ -42,10 +42,10 @@ var indexSchema = map[string]*schema.Schema{
 	"comment":            CommentSchema(false),
 	"obj_name":           IdentifierSchema("obj_name", "The name of the source, view, or materialized view on which you want to create an index.", true),
 	"cluster_name": {
-		Description: "The cluster to maintain this index. If not specified, defaults to the active cluster.",
+		Description: "The cluster to maintain this index. If not specified, defaults to the active cluster. Changing the cluster creates the index on the new cluster and drops the original once the new index is hydrated.",
 		Type:        schema.TypeString,
 		Optional:    true,
-		ForceNew:    true,
+		Computed:    true,
 	},
 	"method": {
 		Description:  "The name of the index method to use.",
@@ -85,6 +85,8 @@ func Index() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: ReadyTimeouts(),
+
 		Schema: indexSchema,
 	}
 }
@@ -92,6 +94,12 @@ func Index() *schema.Resource {
 func indexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	i := d.Id()
 	s, err := materialize.ScanIndex(meta.(*sqlx.DB), i)
+	if obj := d.Get("obj_name").([]interface{}); err == sql.ErrNoRows && d.Get("name").(string) != "" && len(obj) > 0 {
+		// Indexes are recreated with a new id when the materialized view they
+		// are on is moved to another cluster
+		s, err = indexReplaced(meta.(*sqlx.DB), d.Get("name").(string), obj[0].(map[string]interface{}))
+		i = s.IndexId.String
+	}
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -118,6 +126,29 @@ func indexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("cluster_name", s.ClusterName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	obj := []interface{}{
+		map[string]interface{}{
+			"name":          s.ObjectName.String,
+			"schema_name":   s.ObjectSchemaName.String,
+			"database_name": s.ObjectDatabaseName.String,
+		},
+	}
+	if err := d.Set("obj_name", obj); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Arrangement is the only index method. It is only read when it is not
+	// configured, such as on import, so the configured case is kept
+	if _, ok := d.GetOk("method"); !ok {
+		if err := d.Set("method", "ARRANGEMENT"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if err := d.Set("comment", s.Comment.String); err != nil {
 		return diag.FromErr(err)
 	}
@@ -142,6 +173,21 @@ func indexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	return nil
 }
 
+// Looks up an index that was replaced by name on the object it indexes
+func indexReplaced(conn *sqlx.DB, indexName string, obj map[string]interface{}) (materialize.IndexParams, error) {
+	p := map[string]string{
+		"mz_objects.name":   obj["name"].(string),
+		"mz_schemas.name":   obj["schema_name"].(string),
+		"mz_databases.name": obj["database_name"].(string),
+	}
+
+	i, err := materialize.IndexId(conn, indexName, materialize.EqualPredicates(p)...)
+	if err != nil {
+		return materialize.IndexParams{}, err
+	}
+	return materialize.ScanIndex(conn, i)
+}
+
 func indexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	indexName := d.Get("name").(string)
 	indexDefault := d.Get("default").(bool)
@@ -201,6 +247,12 @@ func indexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	indexName := d.Get("name").(string)
 	o := materialize.MaterializeObject{ObjectType: "INDEX", Name: indexName}
 
+	if d.HasChange("cluster_name") && d.Get("cluster_name").(string) != "" {
+		if err := indexMove(ctx, d, meta.(*sqlx.DB)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
 		b := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
@@ -213,6 +265,85 @@ func indexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	return indexRead(ctx, d, meta)
 }
 
+// Indexes cannot be altered to another cluster. A new index with the same
+// columns is created on the target cluster and the original is only dropped
+// once the new index is hydrated so queries keep being served during the move
+func indexMove(ctx context.Context, d *schema.ResourceData, conn *sqlx.DB) error {
+	indexName := d.Get("name").(string)
+	indexDefault := d.Get("default").(bool)
+	obj := d.Get("obj_name").([]interface{})[0].(map[string]interface{})
+	on := materialize.IdentifierSchemaStruct{
+		Name:         obj["name"].(string),
+		SchemaName:   obj["schema_name"].(string),
+		DatabaseName: obj["database_name"].(string),
+	}
+
+	p, err := materialize.ScanIndex(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	t := materialize.MaterializeObject{ObjectType: "INDEX", Name: indexName + moveSuffix}
+	b := materialize.NewIndexBuilder(conn, t, indexDefault, on)
+	b.ClusterName(d.Get("cluster_name").(string))
+	b.Method(d.Get("method").(string))
+	b.ColExpr(materialize.GetIndexColumnStruct(d.Get("col_expr").([]interface{})))
+
+	if err := b.Create(); err != nil {
+		return err
+	}
+
+	i, err := materialize.IndexId(conn, t.Name)
+	if err != nil {
+		b.Drop()
+		return err
+	}
+
+	if err := waitForObjectHydrated(ctx, conn, "index", i, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		log.Printf("[DEBUG] replacement of index %s did not hydrate, dropping: %s", indexName, t.Name)
+		b.Drop()
+		return err
+	}
+
+	o := materialize.MaterializeObject{ObjectType: "INDEX", Name: indexName}
+	if err := materialize.NewIndexBuilder(conn, o, indexDefault, on).Drop(); err != nil {
+		b.Drop()
+		return err
+	}
+
+	if err := b.Rename(indexName); err != nil {
+		return err
+	}
+	d.SetId(i)
+
+	return indexRestore(conn, p, on)
+}
+
+// Carries the ownership and comment of an index over to the index that
+// replaced it
+func indexRestore(conn *sqlx.DB, p materialize.IndexParams, on materialize.IdentifierSchemaStruct) error {
+	o := materialize.MaterializeObject{
+		ObjectType:   "INDEX",
+		Name:         p.IndexName.String,
+		SchemaName:   on.SchemaName,
+		DatabaseName: on.DatabaseName,
+	}
+
+	if p.OwnerName.String != "" {
+		if err := materialize.NewOwnershipBuilder(conn, o).Alter(p.OwnerName.String); err != nil {
+			return err
+		}
+	}
+
+	if p.Comment.String != "" {
+		if err := materialize.NewIndexBuilder(conn, o, false, on).Comment(p.Comment.String); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func indexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	obj := d.Get("obj_name").([]interface{})[0].(map[string]interface{})
 	name := d.Get("name").(string)
@@ -232,5 +363,10 @@ func indexDelete(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+
+	err := waitForObjectDropped(ctx, "index", d.Id(), d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := materialize.ScanIndex(meta.(*sqlx.DB), d.Id())
+		return err
+	})
+	return diag.FromErr(err)
 }
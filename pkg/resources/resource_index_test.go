@@ -48,6 +48,154 @@ func TestResourceIndexCreate(t *testing.T) {
 	})
 }
 
+func TestResourceIndexUpdateCluster(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "index",
+		"default":      false,
+		"obj_name":     []interface{}{map[string]interface{}{"name": "source", "schema_name": "schema", "database_name": "database"}},
+		"cluster_name": "cluster",
+		"col_expr":     []interface{}{map[string]interface{}{"field": "column"}},
+	}
+	d := schema.TestResourceDataRaw(t, Index().Schema, in)
+
+	// Set current state
+	d.SetId("u2")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Query current index
+		testhelpers.MockIndexScan(mock, `WHERE mz_indexes.id = 'u2' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`)
+
+		// Create replacement
+		mock.ExpectExec(
+			`CREATE INDEX index_tf_move IN CLUSTER cluster ON "database"."schema"."source" USING ARRANGEMENT \(column\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_indexes.name = 'index_tf_move' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		testhelpers.MockIndexScan(mock, ip)
+
+		// Query Hydration
+		testhelpers.MockHydrationScan(mock, `WHERE mz_hydration_statuses.object_id = 'u1'`, true)
+
+		// Swap
+		mock.ExpectExec(`DROP INDEX "database"."schema"."index" RESTRICT;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER INDEX "database"."schema"."index_tf_move" RENAME TO "index";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Restore ownership
+		mock.ExpectExec(`ALTER INDEX "database"."schema"."index" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_indexes.id = 'u1' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		testhelpers.MockIndexScan(mock, pp)
+
+		// Query Columns
+		cp := `WHERE mz_indexes.id = 'u1'`
+		testhelpers.MockIndexColumnScan(mock, cp)
+
+		if err := indexUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal("u1", d.Id())
+	})
+}
+
+func TestResourceIndexUpdateClusterDefault(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "index",
+		"default":      true,
+		"obj_name":     []interface{}{map[string]interface{}{"name": "source", "schema_name": "schema", "database_name": "database"}},
+		"cluster_name": "cluster",
+		"col_expr":     []interface{}{map[string]interface{}{"field": "column"}},
+	}
+	d := schema.TestResourceDataRaw(t, Index().Schema, in)
+
+	// Set current state
+	d.SetId("u2")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockIndexScan(mock, `WHERE mz_indexes.id = 'u2' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`)
+
+		// Create default replacement
+		mock.ExpectExec(
+			`CREATE DEFAULT INDEX index_tf_move IN CLUSTER cluster ON "database"."schema"."source" USING ARRANGEMENT \(\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ip := `WHERE mz_indexes.name = 'index_tf_move' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		testhelpers.MockIndexScan(mock, ip)
+		testhelpers.MockHydrationScan(mock, `WHERE mz_hydration_statuses.object_id = 'u1'`, true)
+
+		mock.ExpectExec(`DROP INDEX "database"."schema"."index" RESTRICT;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER INDEX "database"."schema"."index_tf_move" RENAME TO "index";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER INDEX "database"."schema"."index" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := indexMove(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal("u1", d.Id())
+	})
+}
+
+func TestResourceIndexReadReplaced(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "index",
+		"obj_name": []interface{}{map[string]interface{}{"name": "source", "schema_name": "schema", "database_name": "database"}},
+		"col_expr": []interface{}{map[string]interface{}{"field": "column"}},
+	}
+	d := schema.TestResourceDataRaw(t, Index().Schema, in)
+	d.SetId("u2")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Previous index id no longer exists
+		mock.ExpectQuery(`SELECT .* WHERE mz_indexes.id = \$1 AND mz_objects.type IN \(\$2, \$3, \$4\);`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		// Query replaced index by name on the same object
+		testhelpers.MockIndexScan(mock, `WHERE mz_databases.name = 'database' AND mz_indexes.name = 'index' AND mz_objects.name = 'source' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`)
+		testhelpers.MockIndexScan(mock, `WHERE mz_indexes.id = 'u1' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`)
+		testhelpers.MockIndexColumnScan(mock, `WHERE mz_indexes.id = 'u1'`)
+
+		if err := indexRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal("u1", d.Id())
+	})
+}
+
+func TestResourceIndexReadReplacedOtherObject(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "index",
+		"obj_name": []interface{}{map[string]interface{}{"name": "view", "schema_name": "schema", "database_name": "database"}},
+	}
+	d := schema.TestResourceDataRaw(t, Index().Schema, in)
+	d.SetId("u2")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Previous index id no longer exists
+		mock.ExpectQuery(`SELECT .* WHERE mz_indexes.id = \$1 AND mz_objects.type IN \(\$2, \$3, \$4\);`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		// An index with the same name only exists on another object
+		mock.ExpectQuery(`SELECT .* WHERE mz_databases.name = \$1 AND mz_indexes.name = \$2 AND mz_objects.name = \$3 AND mz_objects.type IN \(\$4, \$5, \$6\) AND mz_schemas.name = \$7;`).
+			WithArgs("database", "index", "view", "source", "view", "materialized-view", "schema").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		if err := indexRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal("", d.Id())
+	})
+}
+
 func TestResourceIndexDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -58,10 +206,14 @@ func TestResourceIndexDelete(t *testing.T) {
 	}
 	d := schema.TestResourceDataRaw(t, Index().Schema, in)
 	r.NotNil(d)
+	d.SetId("u1")
 
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP INDEX "database"."schema"."index" RESTRICT;`).WillReturnResult(sqlmock.NewResult(1, 1))
 
+		// Query dropped object
+		mock.ExpectQuery(`SELECT .* WHERE mz_indexes.id = \$1 AND mz_objects.type IN \(\$2, \$3, \$4\);`).WithArgs("u1", "source", "view", "materialized-view").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
 		if err := indexDelete(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
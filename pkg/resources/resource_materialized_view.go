@@ -3,7 +3,11 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 
@@ -22,7 +26,7 @@ var materializedViewSchema = map[string]*schema.Schema{
 	"qualified_sql_name": QualifiedNameSchema("materialized view"),
 	"comment":            CommentSchema(false),
 	"cluster_name": {
-		Description: "The cluster to maintain the materialized view. If not specified, defaults to the default cluster.",
+		Description: "The cluster to maintain the materialized view. If not specified, defaults to the default cluster. Changing the cluster moves the materialized view in place or, where that is not supported, replaces it and its indexes with ones hydrated on the new cluster.",
 		Type:        schema.TypeString,
 		Optional:    true,
 		Computed:    true,
@@ -35,12 +39,80 @@ var materializedViewSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 	},
 	"statement": {
-		Description: "The SQL statement for the materialized view.",
-		Type:        schema.TypeString,
-		Required:    true,
+		Description:      "The SQL statement for the materialized view.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressStatementDiff,
+	},
+	"refresh": {
+		Description: "The refresh strategy of the materialized view. Materialized views refresh on commit when no strategy is specified. Refresh strategies cannot be altered so changes recreate the materialized view.",
+		Type:        schema.TypeList,
+		Optional:    true,
 		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"on_commit": {
+					Description: "Refresh the materialized view whenever its inputs change.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"at_creation": {
+					Description: "Refresh the materialized view once when it is created.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"at": {
+					Description: "Timestamps at which to refresh the materialized view, such as `2024-01-01 00:00:00`.",
+					Type:        schema.TypeList,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						DiffSuppressFunc: suppressTimestampDiff,
+					},
+					Optional: true,
+					ForceNew: true,
+				},
+				"every": {
+					Description: "Refresh the materialized view periodically.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"interval": {
+								Description:      "The interval between refreshes, such as `1 day`.",
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								ValidateFunc:     validateInterval,
+								DiffSuppressFunc: suppressIntervalDiff,
+							},
+							"aligned_to": {
+								Description:      "The timestamp the refreshes are aligned to. Defaults to the creation time of the materialized view.",
+								Type:             schema.TypeString,
+								Optional:         true,
+								ForceNew:         true,
+								DiffSuppressFunc: suppressAlignedToDiff,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	"retain_history": {
+		Description:      "How long to retain historical data for time travel queries, such as `1 hour`. Changes are applied in place.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validateInterval,
+		DiffSuppressFunc: suppressIntervalDiff,
 	},
-	"ownership_role": OwnershipRoleSchema(),
+	"ownership_role":       OwnershipRoleSchema(),
+	"wait_for_ready":       WaitForReadySchema("materialized view", "hydrated"),
+	"statement_validation": StatementValidationSchema("materialized view"),
 }
 
 func MaterializedView() *schema.Resource {
@@ -52,10 +124,14 @@ func MaterializedView() *schema.Resource {
 		UpdateContext: materializedViewUpdate,
 		DeleteContext: materializedViewDelete,
 
+		CustomizeDiff: materializedViewCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importWithDefaults(materializedViewSchema, "wait_for_ready"),
 		},
 
+		Timeouts: ReadyTimeouts(),
+
 		Schema: materializedViewSchema,
 	}
 }
@@ -102,6 +178,23 @@ func materializedViewRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
+	if err := setStatement(meta.(*sqlx.DB), d, s.CreateSql.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRetainHistory(d, s.RetainHistory.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	strategies, err := materialize.ListMaterializedViewRefreshStrategies(meta.(*sqlx.DB), i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("refresh", refreshState(d.Get("refresh").([]interface{}), strategies)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -111,20 +204,7 @@ func materializedViewCreate(ctx context.Context, d *schema.ResourceData, meta in
 	databaseName := d.Get("database_name").(string)
 
 	o := materialize.MaterializeObject{ObjectType: "MATERIALIZED VIEW", Name: materializedViewName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewMaterializedViewBuilder(meta.(*sqlx.DB), o)
-
-	if v, ok := d.GetOk("cluster_name"); ok && v.(string) != "" {
-		b.ClusterName(v.(string))
-	}
-
-	if v, ok := d.GetOk("not_null_assertion"); ok {
-		nas := materialize.GetSliceValueString(v.([]interface{}))
-		b.NotNullAssertions(nas)
-	}
-
-	if v, ok := d.GetOk("statement"); ok && v.(string) != "" {
-		b.SelectStmt(v.(string))
-	}
+	b := materializedViewBuilder(d, meta.(*sqlx.DB), o)
 
 	// create resource
 	if err := b.Create(); err != nil {
@@ -160,9 +240,42 @@ func materializedViewCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 	d.SetId(i)
 
+	if waitForReady(d) {
+		if err := waitForObjectHydrated(ctx, meta.(*sqlx.DB), "materialized view", i, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return materializedViewRead(ctx, d, meta)
 }
 
+func materializedViewBuilder(d *schema.ResourceData, conn *sqlx.DB, o materialize.MaterializeObject) *materialize.MaterializedViewBuilder {
+	b := materialize.NewMaterializedViewBuilder(conn, o)
+
+	if v, ok := d.GetOk("cluster_name"); ok && v.(string) != "" {
+		b.ClusterName(v.(string))
+	}
+
+	if v, ok := d.GetOk("not_null_assertion"); ok {
+		nas := materialize.GetSliceValueString(v.([]interface{}))
+		b.NotNullAssertions(nas)
+	}
+
+	if v, ok := d.GetOk("refresh"); ok {
+		b.Refresh(materializedViewRefresh(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("retain_history"); ok {
+		b.RetainHistory(v.(string))
+	}
+
+	if v, ok := d.GetOk("statement"); ok && v.(string) != "" {
+		b.SelectStmt(v.(string))
+	}
+
+	return b
+}
+
 func materializedViewUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	materializedViewName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -179,6 +292,21 @@ func materializedViewUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("cluster_name") && d.Get("cluster_name").(string) != "" {
+		if err := materializedViewMove(ctx, d, meta.(*sqlx.DB), o); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("retain_history") {
+		_, newRetainHistory := d.GetChange("retain_history")
+		b := materialize.NewMaterializedViewBuilder(meta.(*sqlx.DB), o)
+
+		if err := b.AlterRetainHistory(newRetainHistory.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
 		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
@@ -200,6 +328,175 @@ func materializedViewUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	return materializedViewRead(ctx, d, meta)
 }
 
+// Suffix of the materialized view that replaces one that is moved
+const moveSuffix = "_tf_move"
+
+// Moves the materialized view to another cluster in place. If Materialize
+// cannot alter the cluster a new materialized view is created on the target
+// cluster and hydrated before the old one is dropped and the new one renamed
+func materializedViewMove(ctx context.Context, d *schema.ResourceData, conn *sqlx.DB, o materialize.MaterializeObject) error {
+	clusterName := d.Get("cluster_name").(string)
+
+	err := materialize.NewMaterializedViewBuilder(conn, o).AlterCluster(clusterName)
+	if err == nil || !materialize.NotSupported(err) {
+		return err
+	}
+	log.Printf("[DEBUG] materialized view %s cannot be moved in place, replacing: %s", o.Name, err)
+
+	deps, err := materialize.ListDependents(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Indexes on the materialized view are recreated on its replacement, any
+	// other dependent would be dropped with it
+	var indexes []materialize.IndexParams
+	var dn []string
+	for _, dep := range deps {
+		if dep.Type.String == "index" {
+			p, err := materialize.ScanIndex(conn, dep.ObjectId.String)
+			if err != nil {
+				return err
+			}
+			indexes = append(indexes, p)
+			continue
+		}
+		qn := materialize.QualifiedName(dep.DatabaseName.String, dep.SchemaName.String, dep.ObjectName.String)
+		dn = append(dn, fmt.Sprintf("%s %s", dep.Type.String, qn))
+	}
+	if len(dn) > 0 {
+		return fmt.Errorf(
+			"materialized view %s cannot be moved to cluster %s because it is depended upon by: %s. Remove or update the dependent objects before changing the cluster",
+			o.Name, clusterName, strings.Join(dn, ", "),
+		)
+	}
+
+	p, err := materialize.ScanMaterializedView(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	t := o
+	t.Name = o.Name + moveSuffix
+	b := materializedViewBuilder(d, conn, t)
+	if err := b.Create(); err != nil {
+		return err
+	}
+
+	i, err := materialize.MaterializedViewId(conn, t)
+	if err != nil {
+		b.Drop()
+		return err
+	}
+
+	ib, err := materializedViewMoveIndexes(conn, indexes, t)
+	drop := func() {
+		for _, x := range ib {
+			x.Drop()
+		}
+		b.Drop()
+	}
+	if err != nil {
+		drop()
+		return err
+	}
+
+	if err := waitForObjectHydrated(ctx, conn, "materialized view", i, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		log.Printf("[DEBUG] replacement of materialized view %s did not hydrate, dropping: %s", o.Name, t.Name)
+		drop()
+		return err
+	}
+
+	for _, x := range ib {
+		ii, err := materialize.IndexId(conn, x.Name())
+		if err == nil {
+			err = waitForObjectHydrated(ctx, conn, "index", ii, d.Timeout(schema.TimeoutUpdate))
+		}
+		if err != nil {
+			log.Printf("[DEBUG] index %s of replacement %s did not hydrate, dropping", x.Name(), t.Name)
+			drop()
+			return err
+		}
+	}
+
+	on := materialize.IdentifierSchemaStruct{Name: o.Name, SchemaName: o.SchemaName, DatabaseName: o.DatabaseName}
+	for _, idx := range indexes {
+		io := materialize.MaterializeObject{ObjectType: "INDEX", Name: idx.IndexName.String}
+		if err := materialize.NewIndexBuilder(conn, io, false, on).Drop(); err != nil {
+			drop()
+			return err
+		}
+	}
+
+	if err := materialize.NewMaterializedViewBuilder(conn, o).Drop(); err != nil {
+		drop()
+		return err
+	}
+
+	if err := b.Rename(o.Name); err != nil {
+		return err
+	}
+	d.SetId(i)
+
+	for n, idx := range indexes {
+		if err := ib[n].Rename(idx.IndexName.String); err != nil {
+			return err
+		}
+
+		if err := indexRestore(conn, idx, on); err != nil {
+			return err
+		}
+	}
+
+	if !d.HasChange("ownership_role") && p.OwnerName.String != "" {
+		if err := materialize.NewOwnershipBuilder(conn, o).Alter(p.OwnerName.String); err != nil {
+			return err
+		}
+	}
+
+	if !d.HasChange("comment") && p.Comment.String != "" {
+		if err := materialize.NewCommentBuilder(conn, o).Object(p.Comment.String); err != nil {
+			return err
+		}
+	}
+
+	return restorePrivileges(conn, p.Privileges.String, o)
+}
+
+// Creates the indexes of a materialized view that is moved on its replacement
+// in the same cluster as the original indexes. The indexes created so far are
+// returned with any error so they can be dropped with the replacement
+func materializedViewMoveIndexes(conn *sqlx.DB, indexes []materialize.IndexParams, t materialize.MaterializeObject) ([]*materialize.IndexBuilder, error) {
+	on := materialize.IdentifierSchemaStruct{Name: t.Name, SchemaName: t.SchemaName, DatabaseName: t.DatabaseName}
+
+	var ib []*materialize.IndexBuilder
+	for _, idx := range indexes {
+		columns, err := materialize.ListIndexColumns(conn, idx.IndexId.String)
+		if err != nil {
+			return ib, err
+		}
+
+		var c []materialize.IndexColumn
+		for _, column := range columns {
+			if column.IndexedColumn.Bool {
+				c = append(c, materialize.IndexColumn{Field: column.Name.String})
+			}
+		}
+
+		io := materialize.MaterializeObject{ObjectType: "INDEX", Name: idx.IndexName.String + moveSuffix}
+		b := materialize.NewIndexBuilder(conn, io, false, on)
+		b.ClusterName(idx.ClusterName.String)
+		b.ColExpr(c)
+
+		if err := b.Create(); err != nil {
+			return ib, err
+		}
+		ib = append(ib, b)
+	}
+
+	return ib, nil
+}
+
 func materializedViewDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	materializedViewName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -211,5 +508,205 @@ func materializedViewDelete(ctx context.Context, d *schema.ResourceData, meta an
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+
+	err := waitForObjectDropped(ctx, "materialized view", d.Id(), d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := materialize.ScanMaterializedView(meta.(*sqlx.DB), d.Id())
+		return err
+	})
+	return diag.FromErr(err)
+}
+
+func materializedViewCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return validateStatement(d, meta, func(method string) error {
+		o := materialize.MaterializeObject{
+			Name:         d.Get("name").(string),
+			SchemaName:   d.Get("schema_name").(string),
+			DatabaseName: d.Get("database_name").(string),
+		}
+		b := materialize.NewMaterializedViewBuilder(meta.(*sqlx.DB), o).SelectStmt(d.Get("statement").(string))
+
+		if v, ok := d.GetOk("cluster_name"); ok && v.(string) != "" {
+			b.ClusterName(v.(string))
+		}
+
+		if v, ok := d.GetOk("not_null_assertion"); ok {
+			b.NotNullAssertions(materialize.GetSliceValueString(v.([]interface{})))
+		}
+
+		if v, ok := d.GetOk("refresh"); ok {
+			b.Refresh(materializedViewRefresh(v.([]interface{})))
+		}
+
+		if v, ok := d.GetOk("retain_history"); ok {
+			b.RetainHistory(v.(string))
+		}
+
+		return b.Validate(method)
+	})
+}
+
+func materializedViewRefresh(v []interface{}) materialize.RefreshStruct {
+	var r materialize.RefreshStruct
+	if len(v) == 0 || v[0] == nil {
+		return r
+	}
+
+	u := v[0].(map[string]interface{})
+	r.OnCommit = u["on_commit"].(bool)
+	r.AtCreation = u["at_creation"].(bool)
+	r.At = materialize.GetSliceValueString(u["at"].([]interface{}))
+
+	for _, e := range u["every"].([]interface{}) {
+		m := e.(map[string]interface{})
+		r.Every = append(r.Every, materialize.RefreshEveryStruct{
+			Interval:  m["interval"].(string),
+			AlignedTo: m["aligned_to"].(string),
+		})
+	}
+
+	return r
+}
+
+// Builds the refresh block from the catalog. Timestamps and intervals are
+// stored in a canonical form so configured values are kept when they are
+// equivalent. Refresh at creation and default alignments resolve to the
+// creation time and are also kept as configured
+func refreshState(current []interface{}, strategies []materialize.RefreshStrategyParams) []interface{} {
+	cur := materializedViewRefresh(current)
+
+	var onCommit bool
+	var at []string
+	var every []materialize.RefreshEveryStruct
+	for _, s := range strategies {
+		switch s.Type.String {
+		case "on-commit":
+			onCommit = true
+		case "at":
+			at = append(at, s.At.String)
+		case "every":
+			every = append(every, materialize.RefreshEveryStruct{Interval: s.Interval.String, AlignedTo: s.AlignedTo.String})
+		}
+	}
+
+	// Refreshing on commit is the default strategy
+	if len(at) == 0 && len(every) == 0 && (len(current) == 0 || (cur.OnCommit && len(cur.At) == 0 && len(cur.Every) == 0 && !cur.AtCreation)) {
+		return current
+	}
+
+	r := map[string]interface{}{
+		"on_commit":   onCommit,
+		"at_creation": false,
+	}
+
+	if matchRefreshAt(cur, at) {
+		r["at_creation"] = cur.AtCreation
+		r["at"] = cur.At
+	} else {
+		var a []string
+		for _, t := range at {
+			a = append(a, formatCatalogTimestamp(t))
+		}
+		r["at"] = a
+	}
+
+	e := []interface{}{}
+	if matchRefreshEvery(cur.Every, every) {
+		for _, c := range cur.Every {
+			e = append(e, map[string]interface{}{"interval": c.Interval, "aligned_to": c.AlignedTo})
+		}
+	} else {
+		for _, c := range every {
+			e = append(e, map[string]interface{}{"interval": c.Interval, "aligned_to": formatCatalogTimestamp(c.AlignedTo)})
+		}
+	}
+	r["every"] = e
+
+	return []interface{}{r}
+}
+
+func matchRefreshAt(cur materialize.RefreshStruct, at []string) bool {
+	extra := 0
+	if cur.AtCreation {
+		extra = 1
+	}
+
+	if len(at) != len(cur.At)+extra {
+		return false
+	}
+
+	used := make([]bool, len(at))
+	for _, c := range cur.At {
+		found := false
+		for i, a := range at {
+			if !used[i] && materialize.EquivalentTimestamps(c, a) {
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchRefreshEvery(cur, every []materialize.RefreshEveryStruct) bool {
+	if len(cur) != len(every) {
+		return false
+	}
+
+	used := make([]bool, len(every))
+	for _, c := range cur {
+		found := false
+		for i, e := range every {
+			if used[i] || !materialize.EquivalentIntervals(c.Interval, e.Interval) {
+				continue
+			}
+
+			if c.AlignedTo == "" || materialize.EquivalentTimestamps(c.AlignedTo, e.AlignedTo) {
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func formatCatalogTimestamp(t string) string {
+	ts, err := materialize.ParseTimestamp(t)
+	if err != nil {
+		return t
+	}
+	return materialize.FormatTimestamp(ts)
+}
+
+// Retain history is stored in milliseconds. Materialized views without a
+// configured value report the default compaction window which is not stored
+func setRetainHistory(d *schema.ResourceData, ms string) error {
+	current := d.Get("retain_history").(string)
+
+	if ms == "" {
+		return d.Set("retain_history", "")
+	}
+
+	v, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse retain history %q: %w", ms, err)
+	}
+	r := time.Duration(v) * time.Millisecond
+
+	if current != "" {
+		if c, err := materialize.ParseInterval(current); err == nil && c == r {
+			return nil
+		}
+	} else if r == materialize.DefaultRetainHistory {
+		return nil
+	}
+
+	return d.Set("retain_history", materialize.FormatInterval(r))
 }
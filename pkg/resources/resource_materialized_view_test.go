@@ -2,8 +2,11 @@ package resources
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"testing"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -39,6 +42,10 @@ func TestResourceMaterializedViewCreate(t *testing.T) {
 		// Query Params
 		pp := `WHERE mz_materialized_views.id = 'u1'`
 		testhelpers.MockMaterializeViewScan(mock, pp)
+		testhelpers.MockSearchPathScan(mock)
+
+		// Query Refresh Strategies
+		testhelpers.MockMaterializedViewRefreshStrategyScan(mock, `WHERE mz_materialized_view_refresh_strategies.materialized_view_id = 'u1'`)
 
 		if err := materializedViewCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -57,10 +64,92 @@ func TestResourceMaterializedViewUpdate(t *testing.T) {
 
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."" RENAME TO "materialized_view";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."old_materialized_view" SET CLUSTER "cluster";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		// Query Params
 		pp := `WHERE mz_materialized_views.id = 'u1'`
 		testhelpers.MockMaterializeViewScan(mock, pp)
+		testhelpers.MockSearchPathScan(mock)
+
+		// Query Refresh Strategies
+		testhelpers.MockMaterializedViewRefreshStrategyScan(mock, `WHERE mz_materialized_view_refresh_strategies.materialized_view_id = 'u1'`)
+
+		if err := materializedViewUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceMaterializedViewCreateRefresh(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":          "materialized_view",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"statement":     "SELECT 1 FROM 1",
+		"refresh": []interface{}{map[string]interface{}{
+			"every": []interface{}{map[string]interface{}{"interval": "1 day", "aligned_to": "2024-01-01 00:00:00"}},
+		}},
+		"retain_history": "1hr",
+	}
+	d := schema.TestResourceDataRaw(t, MaterializedView().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE MATERIALIZED VIEW "database"."schema"."materialized_view" WITH \(REFRESH EVERY '1 day' ALIGNED TO '2024-01-01 00:00:00', RETAIN HISTORY FOR '1hr'\) AS SELECT 1 FROM 1;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_materialized_views.name = 'materialized_view' AND mz_schemas.name = 'schema'`
+		testhelpers.MockMaterializeViewScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_materialized_views.id = 'u1'`
+		testhelpers.MockMaterializeViewScan(mock, pp)
+		testhelpers.MockSearchPathScan(mock)
+
+		// Query Refresh Strategies
+		testhelpers.MockMaterializedViewRefreshStrategyScan(mock, `WHERE mz_materialized_view_refresh_strategies.materialized_view_id = 'u1'`)
+
+		if err := materializedViewCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		// Equivalent values read back from the catalog keep the configured form
+		r.Equal("1hr", d.Get("retain_history"))
+		r.Equal("1 day", d.Get("refresh.0.every.0.interval"))
+		r.Equal("2024-01-01 00:00:00", d.Get("refresh.0.every.0.aligned_to"))
+	})
+}
+
+func TestResourceMaterializedViewUpdateRetainHistory(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":           "materialized_view",
+		"schema_name":    "schema",
+		"database_name":  "database",
+		"statement":      "SELECT 1 FROM 1",
+		"retain_history": "1 hour",
+	}
+	d := schema.TestResourceDataRaw(t, MaterializedView().Schema, in)
+
+	// Set current state
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."" RENAME TO "materialized_view";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" SET \(RETAIN HISTORY FOR '1 hour'\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_materialized_views.id = 'u1'`
+		testhelpers.MockMaterializeViewScan(mock, pp)
+		testhelpers.MockSearchPathScan(mock)
+
+		// Query Refresh Strategies
+		testhelpers.MockMaterializedViewRefreshStrategyScan(mock, `WHERE mz_materialized_view_refresh_strategies.materialized_view_id = 'u1'`)
 
 		if err := materializedViewUpdate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -68,6 +157,132 @@ func TestResourceMaterializedViewUpdate(t *testing.T) {
 	})
 }
 
+func TestResourceMaterializedViewMoveDependents(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, MaterializedView().Schema, inMaterializedView)
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" SET CLUSTER "cluster";`).WillReturnError(fmt.Errorf("ALTER MATERIALIZED VIEW SET CLUSTER not yet supported"))
+
+		// Query Dependents
+		rows := sqlmock.NewRows([]string{"object_id", "referenced_object_id", "object_name", "schema_name", "database_name", "type"}).
+			AddRow("u2", "u1", "view", "schema", "database", "view")
+		mock.ExpectQuery(`SELECT .* WHERE mz_object_dependencies.referenced_object_id = \$1;`).WithArgs("u1").WillReturnRows(rows)
+
+		o := materialize.MaterializeObject{ObjectType: "MATERIALIZED VIEW", Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+		err := materializedViewMove(context.TODO(), d, db, o)
+		if err == nil {
+			t.Fatal("expected move to be blocked by dependent view")
+		}
+
+		e := `materialized view materialized_view cannot be moved to cluster cluster because it is depended upon by: view "database"."schema"."view". Remove or update the dependent objects before changing the cluster`
+		if err.Error() != e {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+}
+
+func TestResourceMaterializedViewMoveIndexes(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, MaterializedView().Schema, inMaterializedView)
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" SET CLUSTER "cluster";`).WillReturnError(fmt.Errorf("ALTER MATERIALIZED VIEW SET CLUSTER not yet supported"))
+
+		// Query Dependents
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`)
+		io := `AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		testhelpers.MockIndexScan(mock, `WHERE mz_indexes.id = 'u2' `+io)
+
+		// Query Params
+		testhelpers.MockMaterializeViewScan(mock, `WHERE mz_materialized_views.id = 'u1'`)
+
+		// Create replacement with its indexes
+		mock.ExpectExec(
+			`CREATE MATERIALIZED VIEW "database"."schema"."materialized_view_tf_move" IN CLUSTER "cluster" WITH \(ASSERT NOT NULL "column_1", ASSERT NOT NULL "column_2"\) AS SELECT 1 FROM 1;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockMaterializeViewScan(mock, `WHERE mz_databases.name = 'database' AND mz_materialized_views.name = 'materialized_view_tf_move' AND mz_schemas.name = 'schema'`)
+		testhelpers.MockIndexColumnScan(mock, `WHERE mz_indexes.id = 'u1'`)
+		mock.ExpectExec(
+			`CREATE INDEX index_tf_move IN CLUSTER cluster ON "database"."schema"."materialized_view_tf_move" \(column\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Hydration
+		testhelpers.MockHydrationScan(mock, `WHERE mz_hydration_statuses.object_id = 'u1'`, true)
+		testhelpers.MockIndexScan(mock, `WHERE mz_indexes.name = 'index_tf_move' `+io)
+		testhelpers.MockHydrationScan(mock, `WHERE mz_hydration_statuses.object_id = 'u1'`, true)
+
+		// Swap
+		mock.ExpectExec(`DROP INDEX "database"."schema"."index" RESTRICT;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`DROP MATERIALIZED VIEW "database"."schema"."materialized_view";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view_tf_move" RENAME TO "materialized_view";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER INDEX "database"."schema"."index_tf_move" RENAME TO "index";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER INDEX "database"."schema"."index" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Restore ownership and privileges
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		mock.ExpectExec(`GRANT SELECT ON TABLE "database"."schema"."materialized_view" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := materialize.MaterializeObject{ObjectType: "MATERIALIZED VIEW", Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+		if err := materializedViewMove(context.TODO(), d, db, o); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal("u1", d.Id())
+	})
+}
+
+func TestRefreshState(t *testing.T) {
+	r := require.New(t)
+
+	every := func(interval, alignedTo string) materialize.RefreshStrategyParams {
+		return materialize.RefreshStrategyParams{
+			Type:      sql.NullString{String: "every", Valid: true},
+			Interval:  sql.NullString{String: interval, Valid: true},
+			AlignedTo: sql.NullString{String: alignedTo, Valid: true},
+		}
+	}
+	onCommit := materialize.RefreshStrategyParams{Type: sql.NullString{String: "on-commit", Valid: true}}
+
+	// The default strategy is not stored
+	r.Empty(refreshState([]interface{}{}, []materialize.RefreshStrategyParams{onCommit}))
+
+	// Unset alignments keep the configured value
+	current := []interface{}{map[string]interface{}{
+		"on_commit":   false,
+		"at_creation": false,
+		"at":          []interface{}{},
+		"every":       []interface{}{map[string]interface{}{"interval": "1d", "aligned_to": ""}},
+	}}
+	s := refreshState(current, []materialize.RefreshStrategyParams{every("1 day", "1704067200000")})
+	r.Equal("1d", s[0].(map[string]interface{})["every"].([]interface{})[0].(map[string]interface{})["interval"])
+
+	// Changes made outside of Terraform are read back
+	s = refreshState(current, []materialize.RefreshStrategyParams{every("02:00:00", "1704067200000")})
+	e := s[0].(map[string]interface{})["every"].([]interface{})[0].(map[string]interface{})
+	r.Equal("02:00:00", e["interval"])
+	r.Equal("2024-01-01 00:00:00+00:00", e["aligned_to"])
+}
+
+func TestSetRetainHistory(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, MaterializedView().Schema, map[string]interface{}{})
+	r.NoError(setRetainHistory(d, "1000"))
+	r.Equal("", d.Get("retain_history"))
+
+	r.NoError(setRetainHistory(d, "86400000"))
+	r.Equal("1 day", d.Get("retain_history"))
+
+	d.Set("retain_history", "24hr")
+	r.NoError(setRetainHistory(d, "86400000"))
+	r.Equal("24hr", d.Get("retain_history"))
+}
+
 func TestResourceMaterializedViewDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -78,10 +293,14 @@ func TestResourceMaterializedViewDelete(t *testing.T) {
 	}
 	d := schema.TestResourceDataRaw(t, MaterializedView().Schema, in)
 	r.NotNil(d)
+	d.SetId("u1")
 
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP MATERIALIZED VIEW "database"."schema"."materialized_view";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
+		// Query dropped object
+		mock.ExpectQuery(`SELECT .* WHERE mz_materialized_views.id = \$1;`).WithArgs("u1").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
 		if err := materializedViewDelete(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
@@ -0,0 +1,197 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
+)
+
+var networkPolicySchema = map[string]*schema.Schema{
+	"name":               ObjectNameSchema("network policy", true, true),
+	"qualified_sql_name": QualifiedNameSchema("network policy"),
+	"ownership_role":     OwnershipRoleSchema(),
+	"rule": {
+		Description: "Rules of the network policy. Connections are only accepted from addresses allowed by at least one rule.",
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Description: "The name of the rule.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"action": {
+					Description:  "The action to take for connections matching the rule. Only `allow` is supported.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "allow",
+					ValidateFunc: validation.StringInSlice([]string{"allow"}, false),
+				},
+				"direction": {
+					Description:  "The direction of traffic the rule applies to. Only `ingress` is supported.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "ingress",
+					ValidateFunc: validation.StringInSlice([]string{"ingress"}, false),
+				},
+				"address": {
+					Description:  "The address the rule applies to in CIDR notation, such as `1.2.3.4/28`.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+		},
+	},
+}
+
+func NetworkPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Network policies restrict the addresses that can connect to the region. A network policy is only enforced once it is set as the `network_policy` system parameter.",
+
+		CreateContext: networkPolicyCreate,
+		ReadContext:   networkPolicyRead,
+		UpdateContext: networkPolicyUpdate,
+		DeleteContext: networkPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: networkPolicySchema,
+	}
+}
+
+func networkPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+	s, err := materialize.ScanNetworkPolicy(meta.(*sqlx.DB), i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(i)
+
+	if err := d.Set("name", s.NetworkPolicyName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	qn := materialize.QualifiedName(s.NetworkPolicyName.String)
+	if err := d.Set("qualified_sql_name", qn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("ownership_role", s.OwnerName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	r, err := materialize.ListNetworkPolicyRules(meta.(*sqlx.DB), i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("rule", networkPolicyRuleState(r)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func networkPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	networkPolicyName := d.Get("name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "NETWORK POLICY", Name: networkPolicyName}
+	b := materialize.NewNetworkPolicyBuilder(meta.(*sqlx.DB), o)
+
+	if v, ok := d.GetOk("rule"); ok {
+		b.Rules(materialize.GetNetworkPolicyRuleStruct(v.(*schema.Set).List()))
+	}
+
+	// create resource
+	if err := b.Create(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// ownership
+	if v, ok := d.GetOk("ownership_role"); ok {
+		ownership := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
+
+		if err := ownership.Alter(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed ownership, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// set id
+	i, err := materialize.NetworkPolicyId(meta.(*sqlx.DB), o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(i)
+
+	return networkPolicyRead(ctx, d, meta)
+}
+
+func networkPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	networkPolicyName := d.Get("name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "NETWORK POLICY", Name: networkPolicyName}
+
+	if d.HasChange("rule") {
+		_, newRules := d.GetChange("rule")
+		b := materialize.NewNetworkPolicyBuilder(meta.(*sqlx.DB), o)
+		b.Rules(materialize.GetNetworkPolicyRuleStruct(newRules.(*schema.Set).List()))
+
+		if err := b.Alter(); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("ownership_role") {
+		_, newRole := d.GetChange("ownership_role")
+		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
+
+		if err := b.Alter(newRole.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return networkPolicyRead(ctx, d, meta)
+}
+
+func networkPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	networkPolicyName := d.Get("name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "NETWORK POLICY", Name: networkPolicyName}
+	b := materialize.NewNetworkPolicyBuilder(meta.(*sqlx.DB), o)
+
+	if err := b.Drop(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func networkPolicyRuleState(rules []materialize.NetworkPolicyRuleParams) []interface{} {
+	r := []interface{}{}
+	for _, rule := range rules {
+		r = append(r, map[string]interface{}{
+			"name":      rule.Name.String,
+			"action":    rule.Action.String,
+			"direction": rule.Direction.String,
+			"address":   rule.Address.String,
+		})
+	}
+	return r
+}
@@ -0,0 +1,96 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inNetworkPolicy = map[string]interface{}{
+	"name": "office_access_policy",
+	"rule": []interface{}{
+		map[string]interface{}{"name": "new_york", "action": "allow", "direction": "ingress", "address": "1.2.3.4/28"},
+		map[string]interface{}{"name": "minnesota", "action": "allow", "direction": "ingress", "address": "2.3.4.5/32"},
+	},
+	"ownership_role": "joe",
+}
+
+func TestResourceNetworkPolicyCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, NetworkPolicy().Schema, inNetworkPolicy)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE NETWORK POLICY "office_access_policy" \(RULES \("minnesota" \(ACTION = 'allow', DIRECTION = 'ingress', ADDRESS = '2.3.4.5/32'\), "new_york" \(ACTION = 'allow', DIRECTION = 'ingress', ADDRESS = '1.2.3.4/28'\)\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Ownership
+		mock.ExpectExec(`ALTER NETWORK POLICY "office_access_policy" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_network_policies.name = 'office_access_policy'`
+		testhelpers.MockNetworkPolicyScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_network_policies.id = 'u1'`
+		testhelpers.MockNetworkPolicyScan(mock, pp)
+
+		// Query Rules
+		rp := `WHERE mz_network_policy_rules.policy_id = 'u1'`
+		testhelpers.MockNetworkPolicyRuleScan(mock, rp)
+
+		if err := networkPolicyCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal(2, d.Get("rule").(*schema.Set).Len())
+	})
+}
+
+func TestResourceNetworkPolicyUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, NetworkPolicy().Schema, inNetworkPolicy)
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER NETWORK POLICY "office_access_policy" SET \(RULES \("minnesota" \(ACTION = 'allow', DIRECTION = 'ingress', ADDRESS = '2.3.4.5/32'\), "new_york" \(ACTION = 'allow', DIRECTION = 'ingress', ADDRESS = '1.2.3.4/28'\)\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER NETWORK POLICY "office_access_policy" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_network_policies.id = 'u1'`
+		testhelpers.MockNetworkPolicyScan(mock, pp)
+
+		// Query Rules
+		rp := `WHERE mz_network_policy_rules.policy_id = 'u1'`
+		testhelpers.MockNetworkPolicyRuleScan(mock, rp)
+
+		if err := networkPolicyUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceNetworkPolicyDelete(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, NetworkPolicy().Schema, inNetworkPolicy)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`DROP NETWORK POLICY "office_access_policy";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := networkPolicyDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,490 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
+)
+
+var objectPrivilegesTypes = []string{
+	"DATABASE",
+	"SCHEMA",
+	"TABLE",
+	"VIEW",
+	"MATERIALIZED VIEW",
+	"TYPE",
+	"SOURCE",
+	"CONNECTION",
+	"SECRET",
+	"CLUSTER",
+}
+
+var objectPrivilegesSchema = map[string]*schema.Schema{
+	"object_type": {
+		Description:  fmt.Sprintf("The type of the object. One of %s.", strings.Join(objectPrivilegesTypes, ", ")),
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(objectPrivilegesTypes, false),
+	},
+	"name": {
+		Description: "The name of the object.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"schema_name": {
+		Description: "The schema of the object. Required for objects that belong to a schema.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"database_name": {
+		Description: "The database of the object. Required for schemas and objects that belong to a schema.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"grant": {
+		Description: "The privileges of a role on the object. Privileges of roles that are not listed are revoked.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_name": {
+					Description: "The name of the role. Use the `PUBLIC` pseudo-role to grant privileges to all roles.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"privileges": {
+					Description: "The privileges granted to the role.",
+					Type:        schema.TypeSet,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					MinItems:    1,
+				},
+			},
+		},
+	},
+}
+
+func ObjectPrivileges() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the complete set of privileges on a Materialize object. Privileges granted outside of the resource are revoked. " +
+			"The privileges of the object owner and of system roles are not managed, and the owner cannot be listed in a grant.",
+
+		CreateContext: objectPrivilegesCreate,
+		ReadContext:   objectPrivilegesRead,
+		UpdateContext: objectPrivilegesUpdate,
+		DeleteContext: objectPrivilegesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: objectPrivilegesImport,
+		},
+
+		CustomizeDiff: objectPrivilegesCustomizeDiff,
+
+		Schema: objectPrivilegesSchema,
+	}
+}
+
+type objectPrivilegesKey struct {
+	objectType string
+	objectId   string
+}
+
+func (k objectPrivilegesKey) String() string {
+	return fmt.Sprintf(`PRIVILEGES|%s|%s`, k.objectType, k.objectId)
+}
+
+func parseObjectPrivilegesKey(id string) (objectPrivilegesKey, error) {
+	ie := strings.Split(id, "|")
+
+	if len(ie) != 3 || ie[0] != "PRIVILEGES" {
+		return objectPrivilegesKey{}, fmt.Errorf("%s cannot be parsed correctly", id)
+	}
+
+	return objectPrivilegesKey{objectType: ie[1], objectId: ie[2]}, nil
+}
+
+// The privileges of each role on the object, excluding the owner and system
+// roles. Materialize records the owner as the grantor of every privilege, so
+// the entry of the owner is the one it granted to itself. Altering the owner
+// rewrites the grantor of every entry, so privileges a former owner still holds
+// are granted by the new owner and are managed like those of any other role
+func managedPrivileges(privileges string) map[string][]string {
+	o := map[string][]string{}
+
+	for _, item := range materialize.ParseAclItems(privileges) {
+		if item.Grantee == item.Grantor || strings.HasPrefix(item.Grantee, "s") {
+			continue
+		}
+
+		for _, p := range item.Privileges {
+			if !materialize.HasPrivilege(o[item.Grantee], p) {
+				o[item.Grantee] = append(o[item.Grantee], p)
+			}
+		}
+	}
+
+	return o
+}
+
+// The owner of the object, which Materialize records as the grantor of every
+// privilege
+func objectPrivilegesOwnerId(privileges string) string {
+	for _, item := range materialize.ParseAclItems(privileges) {
+		return item.Grantor
+	}
+	return ""
+}
+
+// The entry of the owner is not read back, so a grant to the owner would be
+// applied again on every apply
+func objectPrivilegesOwnerError(roleName string) error {
+	return fmt.Errorf("role %s owns the object, the privileges of the owner are not managed", roleName)
+}
+
+// The privileges of each role in the configuration
+func getObjectPrivilegesGrants(v interface{}) map[string][]string {
+	o := map[string][]string{}
+
+	for _, g := range v.(*schema.Set).List() {
+		grant := g.(map[string]interface{})
+		roleName := grant["role_name"].(string)
+
+		for _, p := range grant["privileges"].(*schema.Set).List() {
+			if !materialize.HasPrivilege(o[roleName], p.(string)) {
+				o[roleName] = append(o[roleName], p.(string))
+			}
+		}
+	}
+
+	return o
+}
+
+func objectPrivilegesObject(d *schema.ResourceData) materialize.MaterializeObject {
+	return materialize.MaterializeObject{
+		ObjectType:   d.Get("object_type").(string),
+		Name:         d.Get("name").(string),
+		SchemaName:   d.Get("schema_name").(string),
+		DatabaseName: d.Get("database_name").(string),
+	}
+}
+
+func objectPrivilegesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	objectType := d.Get("object_type").(string)
+	validate := validPrivileges(objectType)
+
+	// Read returns a single grant per role. Roles that are unknown until
+	// apply are empty
+	roles := map[string]bool{}
+	for _, g := range d.Get("grant").(*schema.Set).List() {
+		roleName := g.(map[string]interface{})["role_name"].(string)
+		if roleName == "" {
+			continue
+		}
+		if roles[roleName] {
+			return fmt.Errorf("role %s is listed in more than one grant", roleName)
+		}
+		roles[roleName] = true
+	}
+
+	for roleName, privileges := range getObjectPrivilegesGrants(d.Get("grant")) {
+		for _, p := range privileges {
+			if p == "" {
+				continue
+			}
+			if _, errs := validate(p, fmt.Sprintf("privileges of %s", roleName)); len(errs) > 0 {
+				return errs[0]
+			}
+		}
+	}
+
+	// Objects and roles that do not exist yet are checked on apply
+	conn, ok := meta.(*sqlx.DB)
+	if !ok || d.Id() == "" || d.HasChanges("object_type", "name", "schema_name", "database_name") {
+		return nil
+	}
+
+	key, err := parseObjectPrivilegesKey(d.Id())
+	if err != nil {
+		return err
+	}
+
+	privileges, err := materialize.ScanPrivileges(conn, key.objectType, key.objectId)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	ownerId := objectPrivilegesOwnerId(privileges)
+
+	var roleNames []string
+	for roleName := range roles {
+		roleNames = append(roleNames, roleName)
+	}
+	sort.Strings(roleNames)
+
+	for _, roleName := range roleNames {
+		roleId, err := materialize.RoleId(conn, roleName)
+		if err == sql.ErrNoRows {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if roleId == ownerId {
+			return objectPrivilegesOwnerError(roleName)
+		}
+	}
+
+	return nil
+}
+
+func objectPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	key, err := parseObjectPrivilegesKey(i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privileges, err := materialize.ScanPrivileges(meta.(*sqlx.DB), key.objectType, key.objectId)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privilegeMap := managedPrivileges(privileges)
+
+	var roleIds []string
+	for roleId := range privilegeMap {
+		roleIds = append(roleIds, roleId)
+	}
+	sort.Strings(roleIds)
+
+	grants := []interface{}{}
+	for _, roleId := range roleIds {
+		roleName, err := objectPrivilegesRoleName(meta.(*sqlx.DB), roleId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		grants = append(grants, map[string]interface{}{
+			"role_name":  roleName,
+			"privileges": privilegeMap[roleId],
+		})
+	}
+
+	if err := d.Set("grant", grants); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func objectPrivilegesRoleName(conn *sqlx.DB, roleId string) (string, error) {
+	if roleId == "p" {
+		return "PUBLIC", nil
+	}
+
+	r, err := materialize.ScanRole(conn, roleId)
+	if err != nil {
+		return "", err
+	}
+	return r.RoleName.String, nil
+}
+
+// Revokes the privileges on the object that are not in the configuration and
+// grants the missing ones
+func reconcileObjectPrivileges(conn *sqlx.DB, o materialize.MaterializeObject, objectId string, grants map[string][]string) error {
+	privileges, err := materialize.ScanPrivileges(conn, o.ObjectType, objectId)
+	if err != nil {
+		return err
+	}
+	current := managedPrivileges(privileges)
+	ownerId := objectPrivilegesOwnerId(privileges)
+
+	var grantRoleNames []string
+	for roleName := range grants {
+		grantRoleNames = append(grantRoleNames, roleName)
+	}
+	sort.Strings(grantRoleNames)
+
+	roleNames := map[string]string{}
+	desired := map[string][]string{}
+	for _, roleName := range grantRoleNames {
+		roleId, err := materialize.RoleId(conn, roleName)
+		if err != nil {
+			return err
+		}
+		if roleId == ownerId {
+			return objectPrivilegesOwnerError(roleName)
+		}
+		roleNames[roleId] = roleName
+		desired[roleId] = grants[roleName]
+	}
+
+	var revokeRoleIds []string
+	for roleId := range current {
+		revokeRoleIds = append(revokeRoleIds, roleId)
+	}
+	sort.Strings(revokeRoleIds)
+
+	for _, roleId := range revokeRoleIds {
+		var revoke []string
+		for _, p := range current[roleId] {
+			if !materialize.HasPrivilege(desired[roleId], p) {
+				revoke = append(revoke, p)
+			}
+		}
+		if len(revoke) == 0 {
+			continue
+		}
+		sort.Strings(revoke)
+
+		roleName, ok := roleNames[roleId]
+		if !ok {
+			roleName, err = objectPrivilegesRoleName(conn, roleId)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, p := range revoke {
+			log.Printf("[DEBUG] revoking unmanaged privilege %s on %s from %s", p, o.QualifiedName(), roleName)
+			if err := materialize.NewPrivilegeBuilder(conn, roleName, p, o).Revoke(); err != nil {
+				return err
+			}
+		}
+	}
+
+	var grantRoleIds []string
+	for roleId := range desired {
+		grantRoleIds = append(grantRoleIds, roleId)
+	}
+	sort.Strings(grantRoleIds)
+
+	for _, roleId := range grantRoleIds {
+		grant := append([]string{}, desired[roleId]...)
+		sort.Strings(grant)
+
+		for _, p := range grant {
+			if materialize.HasPrivilege(current[roleId], p) {
+				continue
+			}
+			if err := materialize.NewPrivilegeBuilder(conn, roleNames[roleId], p, o).Grant(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func objectPrivilegesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o := objectPrivilegesObject(d)
+
+	i, err := materialize.ObjectId(meta.(*sqlx.DB), o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := reconcileObjectPrivileges(meta.(*sqlx.DB), o, i, getObjectPrivilegesGrants(d.Get("grant"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	key := objectPrivilegesKey{objectType: o.ObjectType, objectId: i}
+	d.SetId(key.String())
+
+	return objectPrivilegesRead(ctx, d, meta)
+}
+
+func objectPrivilegesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	key, err := parseObjectPrivilegesKey(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("grant") {
+		o := objectPrivilegesObject(d)
+
+		if err := reconcileObjectPrivileges(meta.(*sqlx.DB), o, key.objectId, getObjectPrivilegesGrants(d.Get("grant"))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return objectPrivilegesRead(ctx, d, meta)
+}
+
+// Revokes the privileges in the configuration. Privileges of the owner and
+// system roles are left in place
+func objectPrivilegesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o := objectPrivilegesObject(d)
+	grants := getObjectPrivilegesGrants(d.Get("grant"))
+
+	var roleNames []string
+	for roleName := range grants {
+		roleNames = append(roleNames, roleName)
+	}
+	sort.Strings(roleNames)
+
+	for _, roleName := range roleNames {
+		revoke := append([]string{}, grants[roleName]...)
+		sort.Strings(revoke)
+
+		for _, p := range revoke {
+			if err := materialize.NewPrivilegeBuilder(meta.(*sqlx.DB), roleName, p, o).Revoke(); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sets the object from the id so imported privileges match their configuration
+func objectPrivilegesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	key, err := parseObjectPrivilegesKey(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := grantObjectAttributes(meta.(*sqlx.DB), key.objectType, key.objectId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("object_type", key.objectType); err != nil {
+		return nil, err
+	}
+
+	// The name of the object is stored under the attribute of its type
+	for k, v := range attributes {
+		switch {
+		case k == "database_name" && key.objectType == "DATABASE":
+			k = "name"
+		case k == "schema_name" && key.objectType == "SCHEMA":
+			k = "name"
+		case k != "database_name" && k != "schema_name":
+			k = "name"
+		}
+
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
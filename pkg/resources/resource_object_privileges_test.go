@@ -0,0 +1,287 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inObjectPrivileges = map[string]interface{}{
+	"object_type":   "TABLE",
+	"name":          "table",
+	"schema_name":   "schema",
+	"database_name": "database",
+	"grant": []interface{}{
+		map[string]interface{}{"role_name": "joe", "privileges": []interface{}{"SELECT"}},
+		map[string]interface{}{"role_name": "PUBLIC", "privileges": []interface{}{"SELECT"}},
+	},
+}
+
+func TestManagedPrivileges(t *testing.T) {
+	r := require.New(t)
+
+	p := managedPrivileges("{u18=arwd/u18,s2=r/u18,p=r/u18,u3=rw/u18}")
+	r.Equal(map[string][]string{
+		"p":  {"SELECT"},
+		"u3": {"SELECT", "UPDATE"},
+	}, p)
+}
+
+func TestManagedPrivilegesFormerOwner(t *testing.T) {
+	r := require.New(t)
+
+	// Owner altered from u18 to u3, u18 keeps the privileges granted by u3
+	p := managedPrivileges("{u3=arwd/u3,u18=arwd/u3,p=r/u3}")
+	r.Equal(map[string][]string{
+		"p":   {"SELECT"},
+		"u18": {"INSERT", "SELECT", "UPDATE", "DELETE"},
+	}, p)
+}
+
+func TestResourceObjectPrivilegesRevokeFormerOwner(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type":   "SCHEMA",
+		"name":          "schema",
+		"database_name": "database",
+		"grant": []interface{}{
+			map[string]interface{}{"role_name": "PUBLIC", "privileges": []interface{}{"USAGE"}},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, ObjectPrivileges().Schema, in)
+	r.NotNil(d)
+	d.SetId("PRIVILEGES|SCHEMA|u1")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Owner altered from u1 to u18, the former owner keeps its privileges
+		cols := []string{"id", "schema_name", "database_name", "owner_name", "privileges"}
+		acl := sqlmock.NewRows(cols).AddRow("u1", "schema", "database", "mike", "{u18=UC/u18,u1=UC/u18}")
+		mock.ExpectQuery(`SELECT .* WHERE mz_schemas.id = \$1;`).WithArgs("u1").WillReturnRows(acl)
+
+		// Revoke the privileges of the former owner
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		mock.ExpectExec(`REVOKE CREATE ON SCHEMA "database"."schema" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`REVOKE USAGE ON SCHEMA "database"."schema" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`GRANT USAGE ON SCHEMA "database"."schema" TO PUBLIC;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		acl = sqlmock.NewRows(cols).AddRow("u1", "schema", "database", "mike", "{u18=UC/u18,p=U/u18}")
+		mock.ExpectQuery(`SELECT .* WHERE mz_schemas.id = \$1;`).WithArgs("u1").WillReturnRows(acl)
+
+		if err := objectPrivilegesUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		grants := d.Get("grant").(*schema.Set).List()
+		r.Len(grants, 1)
+		r.Equal("PUBLIC", grants[0].(map[string]interface{})["role_name"])
+	})
+}
+
+func TestResourceObjectPrivilegesCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ObjectPrivileges().Schema, inObjectPrivileges)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Query Object Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_tables.name = 'table'`
+		testhelpers.MockTableScan(mock, ip)
+
+		// Query Privileges
+		pp := `WHERE mz_tables.id = 'u1'`
+		testhelpers.MockTableScan(mock, pp)
+
+		// Query Role Id
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'joe'`)
+
+		// Revoke unmanaged privileges
+		mock.ExpectExec(`REVOKE DELETE ON TABLE "database"."schema"."table" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`REVOKE INSERT ON TABLE "database"."schema"."table" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`REVOKE UPDATE ON TABLE "database"."schema"."table" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Grant missing privileges
+		mock.ExpectExec(`GRANT SELECT ON TABLE "database"."schema"."table" TO PUBLIC;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		testhelpers.MockTableScan(mock, pp)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+
+		if err := objectPrivilegesCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("PRIVILEGES|TABLE|u1", d.Id())
+	})
+}
+
+func TestResourceObjectPrivilegesCreateOwner(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ObjectPrivileges().Schema, inObjectPrivileges)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Query Object Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_tables.name = 'table'`
+		testhelpers.MockTableScan(mock, ip)
+
+		// Query Privileges, owned by u18
+		testhelpers.MockTableScan(mock, `WHERE mz_tables.id = 'u1'`)
+
+		// Query Role Id of the owner
+		cols := []string{"id", "role_name", "inherit"}
+		mock.ExpectQuery(`SELECT .* WHERE mz_roles.name = \$1`).WithArgs("joe").WillReturnRows(sqlmock.NewRows(cols).AddRow("u18", "joe", true))
+
+		err := objectPrivilegesCreate(context.TODO(), d, db)
+		r.NotNil(err)
+		r.Contains(err[0].Summary, "role joe owns the object")
+	})
+}
+
+func TestResourceObjectPrivilegesCustomizeDiffOwner(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type":   "SCHEMA",
+		"name":          "schema",
+		"database_name": "database",
+		"grant": []interface{}{
+			map[string]interface{}{"role_name": "joe", "privileges": []interface{}{"USAGE"}},
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "PRIVILEGES|SCHEMA|u1",
+		Attributes: map[string]string{
+			"id":            "PRIVILEGES|SCHEMA|u1",
+			"object_type":   "SCHEMA",
+			"name":          "schema",
+			"database_name": "database",
+			"grant.#":       "0",
+		},
+	}
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Query Privileges, owned by u1
+		cols := []string{"id", "schema_name", "database_name", "owner_name", "privileges"}
+		acl := sqlmock.NewRows(cols).AddRow("u1", "schema", "database", "joe", "{u1=UC/u1,p=U/u1}")
+		mock.ExpectQuery(`SELECT .* WHERE mz_schemas.id = \$1;`).WithArgs("u1").WillReturnRows(acl)
+
+		// Query Role Id of the owner
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'joe'`)
+
+		_, err := ObjectPrivileges().Diff(context.TODO(), state, terraform.NewResourceConfigRaw(in), db)
+		r.ErrorContains(err, "role joe owns the object")
+	})
+}
+
+func TestResourceObjectPrivilegesRevokeUnknownRole(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type":   "TABLE",
+		"name":          "table",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"grant": []interface{}{
+			map[string]interface{}{"role_name": "PUBLIC", "privileges": []interface{}{"SELECT"}},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, ObjectPrivileges().Schema, in)
+	r.NotNil(d)
+	d.SetId("PRIVILEGES|TABLE|u1")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Query Privileges
+		pp := `WHERE mz_tables.id = 'u1'`
+		testhelpers.MockTableScan(mock, pp)
+
+		// Query name of the role that is not in the configuration
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+
+		mock.ExpectExec(`REVOKE DELETE ON TABLE "database"."schema"."table" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`REVOKE INSERT ON TABLE "database"."schema"."table" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`REVOKE SELECT ON TABLE "database"."schema"."table" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`REVOKE UPDATE ON TABLE "database"."schema"."table" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`GRANT SELECT ON TABLE "database"."schema"."table" TO PUBLIC;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		testhelpers.MockTableScan(mock, pp)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+
+		if err := objectPrivilegesUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceObjectPrivilegesRead(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ObjectPrivileges().Schema, inObjectPrivileges)
+	r.NotNil(d)
+	d.SetId("PRIVILEGES|TABLE|u1")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockTableScan(mock, `WHERE mz_tables.id = 'u1'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+
+		if err := objectPrivilegesRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		grants := d.Get("grant").(*schema.Set).List()
+		r.Len(grants, 1)
+
+		g := grants[0].(map[string]interface{})
+		r.Equal("joe", g["role_name"])
+		r.Equal(4, g["privileges"].(*schema.Set).Len())
+	})
+}
+
+func TestResourceObjectPrivilegesDelete(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ObjectPrivileges().Schema, inObjectPrivileges)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`REVOKE SELECT ON TABLE "database"."schema"."table" FROM PUBLIC;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`REVOKE SELECT ON TABLE "database"."schema"."table" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := objectPrivilegesDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceObjectPrivilegesImport(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, ObjectPrivileges().Schema, map[string]interface{}{})
+	d.SetId("PRIVILEGES|SCHEMA|u1")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockSchemaScan(mock, `WHERE mz_schemas.id = 'u1'`)
+
+		s, err := objectPrivilegesImport(context.TODO(), d, db)
+		r.NoError(err)
+		r.Len(s, 1)
+
+		r.Equal("SCHEMA", d.Get("object_type"))
+		r.Equal("schema", d.Get("name"))
+		r.Equal("", d.Get("schema_name"))
+		r.Equal("database", d.Get("database_name"))
+	})
+}
+
+func TestResourceObjectPrivilegesInvalidId(t *testing.T) {
+	r := require.New(t)
+
+	_, err := parseObjectPrivilegesKey("GRANT|TABLE|u1|u1|INSERT")
+	r.Error(err)
+}
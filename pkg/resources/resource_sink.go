@@ -44,6 +44,12 @@ func sinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
+	if s.Topic.Valid {
+		if err := d.Set("topic", s.Topic.String); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if err := d.Set("ownership_role", s.OwnerName.String); err != nil {
 		return diag.FromErr(err)
 	}
@@ -57,6 +63,10 @@ func sinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
+	if err := setObjectStatus(d, meta.(*sqlx.DB), i, materialize.ScanSinkStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -77,11 +87,18 @@ func sinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 	}
 
-	if d.HasChange("size") {
+	// Sinks moved from a linked cluster to an existing cluster no longer have a size
+	if d.HasChange("size") && d.Get("size").(string) != "" {
 		_, newSize := d.GetChange("size")
 		if err := b.Resize(newSize.(string)); err != nil {
 			return diag.FromErr(err)
 		}
+
+		if waitForReady(d) {
+			if err := waitForSinkRunning(ctx, meta.(*sqlx.DB), d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	if d.HasChange("ownership_role") {
@@ -116,5 +133,10 @@ func sinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+
+	err := waitForObjectDropped(ctx, "sink", d.Id(), d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := materialize.ScanSink(meta.(*sqlx.DB), d.Id())
+		return err
+	})
+	return diag.FromErr(err)
 }
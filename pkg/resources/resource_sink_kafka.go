@@ -2,12 +2,15 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -17,9 +20,9 @@ var sinkKafkaSchema = map[string]*schema.Schema{
 	"database_name":      DatabaseNameSchema("sink", false),
 	"qualified_sql_name": QualifiedNameSchema("sink"),
 	"comment":            CommentSchema(false),
-	"cluster_name":       ObjectClusterNameSchema("sink"),
+	"cluster_name":       ObjectClusterNameSchema("sink", false),
 	"size":               ObjectSizeSchema("sink"),
-	"from":               IdentifierSchema("from", "The name of the source, table or materialized view you want to send to the sink.", true),
+	"from":               sinkFromSchema(),
 	"kafka_connection":   IdentifierSchema("kafka_connection", "The name of the Kafka connection to use in the sink.", true),
 	"topic": {
 		Description: "The Kafka topic you want to subscribe to.",
@@ -27,6 +30,40 @@ var sinkKafkaSchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
+	"compression_type": {
+		Description:  "The type of compression to apply to messages before they are sent to Kafka.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(compressionTypes, true),
+	},
+	"partition_by": {
+		Description: "A SQL expression returning a hash that is used to assign messages to partitions of the topic.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"topic_partition_count": {
+		Description:  "The number of partitions to create for the topic if it does not exist. Defaults to the broker default.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"topic_replication_factor": {
+		Description:  "The replication factor to use for the topic if it does not exist. Defaults to the broker default.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"topic_config": {
+		Description: "Configuration parameters to set on the topic if it does not exist, e.g. `cleanup.policy`.",
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+	},
 	"key": {
 		Description: "An optional list of columns to use for the Kafka key. If unspecified, the Kafka key is left unset.",
 		Type:        schema.TypeList,
@@ -34,7 +71,22 @@ var sinkKafkaSchema = map[string]*schema.Schema{
 		Optional:    true,
 		ForceNew:    true,
 	},
-	"format": SinkFormatSpecSchema("format", "How to decode raw bytes from different formats into data structures it can understand at runtime.", false),
+	"key_not_enforced": {
+		Description:  "Disable validation of the key uniqueness. Using a non-unique key can lead to the sink producing incorrect data.",
+		Type:         schema.TypeBool,
+		Optional:     true,
+		ForceNew:     true,
+		RequiredWith: []string{"key"},
+	},
+	"headers": {
+		Description: "The name of a column of type `map[text => text]` or `map[text => bytea]` to emit as Kafka message headers.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"format":       sinkKafkaFormatSchema("format", "How to decode raw bytes from different formats into data structures it can understand at runtime. Conflicts with `key_format` and `value_format`.", []string{"key_format", "value_format"}, nil),
+	"key_format":   sinkKafkaFormatSchema("key_format", "The format of the Kafka message key. Must be set together with `value_format`.", []string{"format"}, []string{"value_format"}),
+	"value_format": sinkKafkaFormatSchema("value_format", "The format of the Kafka message value. Must be set together with `key_format`.", []string{"format"}, []string{"key_format"}),
 	"envelope": {
 		Description: "How to interpret records (e.g. Debezium, Upsert).",
 		Type:        schema.TypeList,
@@ -67,7 +119,28 @@ var sinkKafkaSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Default:     true,
 	},
-	"ownership_role": OwnershipRoleSchema(),
+	"ownership_role":        OwnershipRoleSchema(),
+	"status":                ObjectStatusSchema("sink"),
+	"error":                 ObjectStatusErrorSchema("sink"),
+	"last_status_change_at": ObjectStatusChangeSchema("sink"),
+	"wait_for_ready":        WaitForReadySchema("sink", "running"),
+}
+
+var compressionTypes = []string{"none", "gzip", "snappy", "lz4", "zstd"}
+
+// The upstream relation can be changed in place as long as the new relation
+// is compatible with the existing one
+func sinkFromSchema() *schema.Schema {
+	s := IdentifierSchema("from", "The name of the source, table or materialized view you want to send to the sink. Changing the relation repoints the sink in place without re-emitting a snapshot, which requires the new relation to be compatible with the current one.", true)
+	s.ForceNew = false
+	return s
+}
+
+func sinkKafkaFormatSchema(elem, description string, conflictsWith, requiredWith []string) *schema.Schema {
+	s := SinkFormatSpecSchema(elem, description, false)
+	s.ConflictsWith = conflictsWith
+	s.RequiredWith = requiredWith
+	return s
 }
 
 func SinkKafka() *schema.Resource {
@@ -76,13 +149,17 @@ func SinkKafka() *schema.Resource {
 
 		CreateContext: sinkKafkaCreate,
 		ReadContext:   sinkRead,
-		UpdateContext: sinkUpdate,
+		UpdateContext: sinkKafkaUpdate,
 		DeleteContext: sinkDelete,
 
+		CustomizeDiff: sinkKafkaCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: ReadyTimeouts(),
+
 		Schema: sinkKafkaSchema,
 	}
 }
@@ -117,16 +194,56 @@ func sinkKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag
 		b.Topic(v.(string))
 	}
 
+	if v, ok := d.GetOk("compression_type"); ok {
+		b.CompressionType(v.(string))
+	}
+
+	if v, ok := d.GetOk("partition_by"); ok {
+		b.PartitionBy(v.(string))
+	}
+
+	if v, ok := d.GetOk("topic_partition_count"); ok {
+		b.TopicPartitionCount(v.(int))
+	}
+
+	if v, ok := d.GetOk("topic_replication_factor"); ok {
+		b.TopicReplicationFactor(v.(int))
+	}
+
+	if v, ok := d.GetOk("topic_config"); ok {
+		c := map[string]string{}
+		for k, val := range v.(map[string]interface{}) {
+			c[k] = val.(string)
+		}
+		b.TopicConfig(c)
+	}
+
 	if v, ok := d.GetOk("key"); ok {
 		keys := materialize.GetSliceValueString(v.([]interface{}))
 		b.Key(keys)
 	}
 
+	if v, ok := d.GetOk("key_not_enforced"); ok {
+		b.KeyNotEnforced(v.(bool))
+	}
+
+	if v, ok := d.GetOk("headers"); ok {
+		b.Headers(v.(string))
+	}
+
 	if v, ok := d.GetOk("format"); ok {
 		format := materialize.GetSinkFormatSpecStruc(v)
 		b.Format(format)
 	}
 
+	if v, ok := d.GetOk("key_format"); ok {
+		b.KeyFormat(materialize.GetSinkFormatSpecStruc(v))
+	}
+
+	if v, ok := d.GetOk("value_format"); ok {
+		b.ValueFormat(materialize.GetSinkFormatSpecStruc(v))
+	}
+
 	if v, ok := d.GetOk("envelope"); ok {
 		envelope := materialize.GetSinkKafkaEnelopeStruct(v)
 		b.Envelope(envelope)
@@ -170,5 +287,129 @@ func sinkKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag
 	}
 	d.SetId(i)
 
+	if waitForReady(d) {
+		if err := waitForSinkRunning(ctx, meta.(*sqlx.DB), i, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return sinkRead(ctx, d, meta)
 }
+
+func sinkKafkaUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if d.HasChange("cluster_name") && d.Get("cluster_name").(string) != "" {
+		oldName, _ := d.GetChange("name")
+		o := materialize.MaterializeObject{
+			ObjectType:   "SINK",
+			Name:         oldName.(string),
+			SchemaName:   d.Get("schema_name").(string),
+			DatabaseName: d.Get("database_name").(string),
+		}
+		b := materialize.NewSink(meta.(*sqlx.DB), o)
+
+		// The sink is never recreated when it cannot be moved in place, which
+		// would re-emit its snapshot to the topic
+		if err := b.AlterCluster(d.Get("cluster_name").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if waitForReady(d) {
+			if err := waitForSinkRunning(ctx, meta.(*sqlx.DB), d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("from") {
+		oldName, _ := d.GetChange("name")
+		schemaName := d.Get("schema_name").(string)
+		databaseName := d.Get("database_name").(string)
+
+		o := materialize.MaterializeObject{ObjectType: "SINK", Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
+		b := materialize.NewSink(meta.(*sqlx.DB), o)
+
+		from := materialize.GetIdentifierSchemaStruct(databaseName, schemaName, d.Get("from"))
+		if err := b.AlterFrom(from); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return sinkUpdate(ctx, d, meta)
+}
+
+func sinkKafkaCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("from") {
+		return nil
+	}
+
+	// The relation is created in the same apply and cannot be inspected yet
+	for _, k := range []string{"from.0.name", "from.0.schema_name", "from.0.database_name"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o, n := d.GetChange("from")
+	oldFrom := materialize.GetIdentifierSchemaStruct(databaseName, schemaName, o)
+	newFrom := materialize.GetIdentifierSchemaStruct(databaseName, schemaName, n)
+
+	return sinkCheckFrom(meta.(*sqlx.DB), oldFrom, newFrom)
+}
+
+// A sink can only be repointed to a relation that can still be written with
+// the schema of the current relation. Every existing column has to be present
+// with the same type and may not become non-nullable. New columns have to be
+// nullable so that consumers of the topic can keep reading older messages
+func sinkCheckFrom(conn *sqlx.DB, oldFrom, newFrom materialize.IdentifierSchemaStruct) error {
+	newColumns, err := materialize.ListRelationColumns(conn, newFrom)
+	if err != nil {
+		return err
+	}
+
+	// The relation does not exist yet, it is validated by Materialize on apply
+	if len(newColumns) == 0 {
+		return nil
+	}
+
+	oldColumns, err := materialize.ListRelationColumns(conn, oldFrom)
+	if err != nil {
+		return err
+	}
+
+	n := map[string]materialize.RelationColumnParams{}
+	for _, c := range newColumns {
+		n[c.Name.String] = c
+	}
+
+	var e []string
+	for _, c := range oldColumns {
+		nc, ok := n[c.Name.String]
+		switch {
+		case !ok:
+			e = append(e, fmt.Sprintf("column %s is missing", c.Name.String))
+		case nc.Type.String != c.Type.String:
+			e = append(e, fmt.Sprintf("column %s changes type from %s to %s", c.Name.String, c.Type.String, nc.Type.String))
+		case c.Nullable.Bool && !nc.Nullable.Bool:
+			e = append(e, fmt.Sprintf("column %s changes from nullable to not nullable", c.Name.String))
+		}
+		delete(n, c.Name.String)
+	}
+
+	for _, c := range newColumns {
+		if _, ok := n[c.Name.String]; ok && !c.Nullable.Bool {
+			e = append(e, fmt.Sprintf("new column %s is not nullable", c.Name.String))
+		}
+	}
+
+	if len(e) > 0 {
+		return fmt.Errorf(
+			"sink cannot be repointed from %s to %s because the relations are not compatible: %s",
+			oldFrom.QualifiedName(), newFrom.QualifiedName(), strings.Join(e, ", "),
+		)
+	}
+
+	return nil
+}
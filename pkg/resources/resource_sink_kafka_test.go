@@ -2,8 +2,10 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -46,8 +48,195 @@ func TestResourceSinkKafkaCreate(t *testing.T) {
 		pp := `WHERE mz_sinks.id = 'u1'`
 		testhelpers.MockSinkScan(mock, pp)
 
+		// Query Status
+		testhelpers.MockSinkStatusScan(mock, `WHERE mz_sink_statuses.id = 'u1'`, "running", "")
+
+		if err := sinkKafkaCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSinkKafkaCreateTopicOptions(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                     "sink",
+		"schema_name":              "schema",
+		"database_name":            "database",
+		"cluster_name":             "cluster",
+		"from":                     []interface{}{map[string]interface{}{"name": "item", "schema_name": "public", "database_name": "database"}},
+		"kafka_connection":         []interface{}{map[string]interface{}{"name": "kafka_conn"}},
+		"topic":                    "topic",
+		"compression_type":         "lz4",
+		"topic_partition_count":    4,
+		"topic_replication_factor": 3,
+		"topic_config":             map[string]interface{}{"cleanup.policy": "compact"},
+		"key":                      []interface{}{"id"},
+		"key_not_enforced":         true,
+		"headers":                  "headers",
+		"key_format":               []interface{}{map[string]interface{}{"text": true}},
+		"value_format":             []interface{}{map[string]interface{}{"json": true}},
+		"envelope":                 []interface{}{map[string]interface{}{"upsert": true}},
+	}
+	d := schema.TestResourceDataRaw(t, SinkKafka().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SINK "database"."schema"."sink" IN CLUSTER "cluster" FROM "database"."public"."item" INTO KAFKA CONNECTION "database"."schema"."kafka_conn" KEY \(id\) NOT ENFORCED \(TOPIC 'topic', COMPRESSION TYPE = 'lz4', TOPIC PARTITION COUNT = 4, TOPIC REPLICATION FACTOR = 3, TOPIC CONFIG = MAP\['cleanup.policy' => 'compact'\]\) HEADERS headers KEY FORMAT TEXT VALUE FORMAT JSON ENVELOPE UPSERT;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sinks.name = 'sink'`
+		testhelpers.MockSinkScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sinks.id = 'u1'`
+		testhelpers.MockSinkScan(mock, pp)
+
+		// Query Status
+		testhelpers.MockSinkStatusScan(mock, `WHERE mz_sink_statuses.id = 'u1'`, "running", "")
+
 		if err := sinkKafkaCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
+
+		r.Equal("topic", d.Get("topic"))
+	})
+}
+
+func TestResourceSinkKafkaUpdateCluster(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":          "sink",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"cluster_name":  "cluster",
+	}
+	d := schema.TestResourceDataRaw(t, SinkKafka().Schema, in)
+
+	// Set current state
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SINK "database"."schema"."" SET CLUSTER "cluster";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER SINK "database"."schema"."" RENAME TO "sink";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_sinks.id = 'u1'`
+		testhelpers.MockSinkScan(mock, pp)
+
+		// Query Status
+		testhelpers.MockSinkStatusScan(mock, `WHERE mz_sink_statuses.id = 'u1'`, "running", "")
+
+		if err := sinkKafkaUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSinkKafkaUpdateClusterNotSupported(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":          "sink",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"cluster_name":  "cluster",
+	}
+	d := schema.TestResourceDataRaw(t, SinkKafka().Schema, in)
+
+	// Set current state
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// The sink is not dropped
+		mock.ExpectExec(`ALTER SINK "database"."schema"."" SET CLUSTER "cluster";`).WillReturnError(fmt.Errorf("ALTER SINK SET CLUSTER is not supported"))
+
+		r.NotNil(sinkKafkaUpdate(context.TODO(), d, db))
+	})
+}
+
+func TestResourceSinkKafkaUpdateFrom(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":          "sink",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"from":          []interface{}{map[string]interface{}{"name": "item_v2", "schema_name": "public", "database_name": "database"}},
+	}
+	d := schema.TestResourceDataRaw(t, SinkKafka().Schema, in)
+
+	// Set current state
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SINK "database"."schema"."" SET FROM "database"."public"."item_v2";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER SINK "database"."schema"."" RENAME TO "sink";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_sinks.id = 'u1'`
+		testhelpers.MockSinkScan(mock, pp)
+
+		// Query Status
+		testhelpers.MockSinkStatusScan(mock, `WHERE mz_sink_statuses.id = 'u1'`, "running", "")
+
+		if err := sinkKafkaUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSinkCheckFrom(t *testing.T) {
+	oldFrom := materialize.IdentifierSchemaStruct{Name: "item", SchemaName: "public", DatabaseName: "database"}
+	newFrom := materialize.IdentifierSchemaStruct{Name: "item_v2", SchemaName: "public", DatabaseName: "database"}
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockRelationColumnScan(mock, `WHERE mz_databases.name = 'database' AND mz_objects.name = 'item_v2' AND mz_schemas.name = 'public'`, [][]string{
+			{"id", "1", "false", "integer"},
+			{"name", "2", "true", "text"},
+			{"note", "3", "true", "text"},
+		})
+		testhelpers.MockRelationColumnScan(mock, `WHERE mz_databases.name = 'database' AND mz_objects.name = 'item' AND mz_schemas.name = 'public'`, [][]string{
+			{"id", "1", "false", "integer"},
+			{"name", "2", "true", "text"},
+		})
+
+		if err := sinkCheckFrom(db, oldFrom, newFrom); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSinkCheckFromIncompatible(t *testing.T) {
+	oldFrom := materialize.IdentifierSchemaStruct{Name: "item", SchemaName: "public", DatabaseName: "database"}
+	newFrom := materialize.IdentifierSchemaStruct{Name: "item_v2", SchemaName: "public", DatabaseName: "database"}
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockRelationColumnScan(mock, `WHERE mz_databases.name = 'database' AND mz_objects.name = 'item_v2' AND mz_schemas.name = 'public'`, [][]string{
+			{"id", "1", "false", "bigint"},
+			{"count", "2", "false", "integer"},
+		})
+		testhelpers.MockRelationColumnScan(mock, `WHERE mz_databases.name = 'database' AND mz_objects.name = 'item' AND mz_schemas.name = 'public'`, [][]string{
+			{"id", "1", "false", "integer"},
+			{"name", "2", "true", "text"},
+		})
+
+		err := sinkCheckFrom(db, oldFrom, newFrom)
+		if err == nil {
+			t.Fatal("expected incompatible relations to be rejected")
+		}
+
+		e := `sink cannot be repointed from "database"."public"."item" to "database"."public"."item_v2" because the relations are not compatible: column id changes type from integer to bigint, column name is missing, new column count is not nullable`
+		if err.Error() != e {
+			t.Fatalf("unexpected error: %s", err)
+		}
 	})
 }
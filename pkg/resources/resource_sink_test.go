@@ -29,6 +29,9 @@ func TestResourceSinkUpdate(t *testing.T) {
 		pp := `WHERE mz_sinks.id = 'u1'`
 		testhelpers.MockSinkScan(mock, pp)
 
+		// Query Status
+		testhelpers.MockSinkStatusScan(mock, `WHERE mz_sink_statuses.id = 'u1'`, "running", "")
+
 		if err := sinkUpdate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
@@ -45,10 +48,14 @@ func TestResourceSinkDelete(t *testing.T) {
 	}
 	d := schema.TestResourceDataRaw(t, SinkKafka().Schema, in)
 	r.NotNil(d)
+	d.SetId("u1")
 
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP SINK "database"."schema"."sink";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
+		// Query dropped object
+		mock.ExpectQuery(`SELECT .* WHERE mz_sinks.id = \$1;`).WithArgs("u1").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
 		if err := sinkDelete(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
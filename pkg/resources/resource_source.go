@@ -57,6 +57,10 @@ func sourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 
+	if err := setObjectStatus(d, meta.(*sqlx.DB), i, materialize.ScanSourceStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Subsources
 	deps, err := materialize.ListDependencies(meta.(*sqlx.DB), i, "source")
 	if err != nil {
@@ -103,6 +107,12 @@ func sourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		if err := b.Resize(newSize.(string)); err != nil {
 			return diag.FromErr(err)
 		}
+
+		if waitForReady(d) {
+			if err := waitForSourceRunning(ctx, meta.(*sqlx.DB), d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	if d.HasChange("ownership_role") {
@@ -137,5 +147,10 @@ func sourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+
+	err := waitForObjectDropped(ctx, "source", d.Id(), d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := materialize.ScanSource(meta.(*sqlx.DB), d.Id())
+		return err
+	})
+	return diag.FromErr(err)
 }
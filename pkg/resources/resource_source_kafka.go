@@ -17,7 +17,7 @@ var sourceKafkaSchema = map[string]*schema.Schema{
 	"database_name":      DatabaseNameSchema("source", false),
 	"qualified_sql_name": QualifiedNameSchema("source"),
 	"comment":            CommentSchema(false),
-	"cluster_name":       ObjectClusterNameSchema("source"),
+	"cluster_name":       ObjectClusterNameSchema("source", true),
 	"size":               ObjectSizeSchema("source"),
 	"kafka_connection":   IdentifierSchema("kafka_connection", "The Kafka connection to use in the source.", true),
 	"topic": {
@@ -141,8 +141,12 @@ var sourceKafkaSchema = map[string]*schema.Schema{
 		Optional:    true,
 		ForceNew:    true,
 	},
-	"subsource":      SubsourceSchema(),
-	"ownership_role": OwnershipRoleSchema(),
+	"subsource":             SubsourceSchema(),
+	"ownership_role":        OwnershipRoleSchema(),
+	"status":                ObjectStatusSchema("source"),
+	"error":                 ObjectStatusErrorSchema("source"),
+	"last_status_change_at": ObjectStatusChangeSchema("source"),
+	"wait_for_ready":        WaitForReadySchema("source", "running"),
 }
 
 func SourceKafka() *schema.Resource {
@@ -158,6 +162,8 @@ func SourceKafka() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: ReadyTimeouts(),
+
 		Schema: sourceKafkaSchema,
 	}
 }
@@ -294,5 +300,11 @@ func sourceKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 	d.SetId(i)
 
+	if waitForReady(d) {
+		if err := waitForSourceRunning(ctx, meta.(*sqlx.DB), i, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return sourceRead(ctx, d, meta)
 }
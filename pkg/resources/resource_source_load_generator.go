@@ -34,7 +34,7 @@ var sourceLoadgenSchema = map[string]*schema.Schema{
 	"database_name":      DatabaseNameSchema("source", false),
 	"qualified_sql_name": QualifiedNameSchema("source"),
 	"comment":            CommentSchema(false),
-	"cluster_name":       ObjectClusterNameSchema("source"),
+	"cluster_name":       ObjectClusterNameSchema("source", true),
 	"size":               ObjectSizeSchema("source"),
 	"load_generator_type": {
 		Description:  fmt.Sprintf("The load generator types: %s.", loadGeneratorTypes),
@@ -104,8 +104,11 @@ var sourceLoadgenSchema = map[string]*schema.Schema{
 		ForceNew:     true,
 		ExactlyOneOf: []string{"counter_options", "auction_options", "marketing_options", "tpch_options"},
 	},
-	"subsource":      SubsourceSchema(),
-	"ownership_role": OwnershipRoleSchema(),
+	"subsource":             SubsourceSchema(),
+	"ownership_role":        OwnershipRoleSchema(),
+	"status":                ObjectStatusSchema("source"),
+	"error":                 ObjectStatusErrorSchema("source"),
+	"last_status_change_at": ObjectStatusChangeSchema("source"),
 }
 
 func SourceLoadgen() *schema.Resource {
@@ -44,6 +44,9 @@ func TestResourceSourceLoadgenCreate(t *testing.T) {
 		pp := `WHERE mz_sources.id = 'u1'`
 		testhelpers.MockSourceScan(mock, pp)
 
+		// Query Status
+		testhelpers.MockSourceStatusScan(mock, `WHERE mz_source_statuses.id = 'u1'`, "running", "")
+
 		// Query Subsources
 		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
 		testhelpers.MockSubsourceScan(mock, ps)
@@ -0,0 +1,244 @@
+package resources
+
+import (
+	"context"
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var sourceMysqlSchema = map[string]*schema.Schema{
+	"name":               ObjectNameSchema("source", true, false),
+	"schema_name":        SchemaNameSchema("source", false),
+	"database_name":      DatabaseNameSchema("source", false),
+	"qualified_sql_name": QualifiedNameSchema("source"),
+	"comment":            CommentSchema(false),
+	"cluster_name":       ObjectClusterNameSchema("source", true),
+	"size":               ObjectSizeSchema("source"),
+	"mysql_connection":   IdentifierSchema("mysql_connection", "The MySQL connection to use in the source.", true),
+	"text_columns": {
+		Description: "Decode data as text for specific columns that contain MySQL types that are unsupported in Materialize. Can only be updated in place when also updating a corresponding `table` attribute.",
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+	},
+	"ignore_columns": {
+		Description: "Ignore specific columns when reading data from MySQL. Useful for columns that contain MySQL types that are unsupported in Materialize.",
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"table": {
+		Description: "Creates subsources for specific tables. If neither table or schema is specified, will default to ALL TABLES",
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Description: "The name of the table.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"alias": {
+					Description: "The alias of the table.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+			},
+		},
+		Optional:      true,
+		MinItems:      1,
+		ConflictsWith: []string{"schema"},
+	},
+	"schema": {
+		Description:   "Creates subsources for specific schemas. If neither table or schema is specified, will default to ALL TABLES",
+		Type:          schema.TypeList,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ForceNew:      true,
+		MinItems:      1,
+		ConflictsWith: []string{"table"},
+	},
+	"expose_progress": {
+		Description: "The name of the progress subsource for the source. If this is not specified, the subsource will be named `<src_name>_progress`.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"subsource":             SubsourceSchema(),
+	"ownership_role":        OwnershipRoleSchema(),
+	"status":                ObjectStatusSchema("source"),
+	"error":                 ObjectStatusErrorSchema("source"),
+	"last_status_change_at": ObjectStatusChangeSchema("source"),
+}
+
+func SourceMysql() *schema.Resource {
+	return &schema.Resource{
+		Description: "A MySQL source describes a MySQL instance you want Materialize to read data from.",
+
+		CreateContext: sourceMysqlCreate,
+		ReadContext:   sourceRead,
+		UpdateContext: sourceMysqlUpdate,
+		DeleteContext: sourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: sourceMysqlSchema,
+	}
+}
+
+func sourceMysqlCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sourceName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "SOURCE", Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewSourceMysqlBuilder(meta.(*sqlx.DB), o)
+
+	if v, ok := d.GetOk("cluster_name"); ok {
+		b.ClusterName(v.(string))
+	}
+
+	if v, ok := d.GetOk("size"); ok {
+		b.Size(v.(string))
+	}
+
+	if v, ok := d.GetOk("mysql_connection"); ok {
+		conn := materialize.GetIdentifierSchemaStruct(databaseName, schemaName, v)
+		b.MysqlConnection(conn)
+	}
+
+	if v, ok := d.GetOk("table"); ok {
+		tables := materialize.GetTableStruct(v.([]interface{}))
+		b.Table(tables)
+	}
+
+	if v, ok := d.GetOk("schema"); ok {
+		schemas := materialize.GetSliceValueString(v.([]interface{}))
+		b.Schema(schemas)
+	}
+
+	if v, ok := d.GetOk("expose_progress"); ok {
+		b.ExposeProgress(v.(string))
+	}
+
+	if v, ok := d.GetOk("text_columns"); ok {
+		columns := materialize.GetSliceValueString(v.([]interface{}))
+		b.TextColumns(columns)
+	}
+
+	if v, ok := d.GetOk("ignore_columns"); ok {
+		columns := materialize.GetSliceValueString(v.([]interface{}))
+		b.IgnoreColumns(columns)
+	}
+
+	// create resource
+	if err := b.Create(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// ownership
+	if v, ok := d.GetOk("ownership_role"); ok {
+		ownership := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
+
+		if err := ownership.Alter(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed ownership, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// object comment
+	if v, ok := d.GetOk("comment"); ok {
+		comment := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+		if err := comment.Object(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// set id
+	i, err := materialize.SourceId(meta.(*sqlx.DB), o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(i)
+
+	return sourceRead(ctx, d, meta)
+}
+
+func sourceMysqlUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sourceName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "SOURCE", Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewSource(meta.(*sqlx.DB), o)
+
+	if d.HasChange("name") {
+		oldName, newName := d.GetChange("name")
+		o := materialize.MaterializeObject{ObjectType: "SOURCE", Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
+		b := materialize.NewSource(meta.(*sqlx.DB), o)
+		if err := b.Rename(newName.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("size") {
+		_, newSize := d.GetChange("size")
+		if err := b.Resize(newSize.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("ownership_role") {
+		_, newRole := d.GetChange("ownership_role")
+		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
+
+		if err := b.Alter(newRole.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("table") {
+		ot, nt := d.GetChange("table")
+		addTables := materialize.DiffTableStructs(nt.([]interface{}), ot.([]interface{}))
+		dropTables := materialize.DiffTableStructs(ot.([]interface{}), nt.([]interface{}))
+
+		if len(addTables) > 0 {
+			var colDiff []string
+			if d.HasChange("text_columns") {
+				oc, nc := d.GetChange("text_columns")
+				colDiff = diffTextColumns(nc.([]interface{}), oc.([]interface{}))
+			}
+
+			if err := b.AddSubsource(addTables, colDiff); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if len(dropTables) > 0 {
+			if err := b.DropSubsource(dropTables); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("comment") {
+		_, newComment := d.GetChange("comment")
+		b := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+		if err := b.Object(newComment.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return sourceRead(ctx, d, meta)
+}
@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inSourceMysqlTable = map[string]interface{}{
+	"name":             "source",
+	"schema_name":      "schema",
+	"database_name":    "database",
+	"cluster_name":     "cluster",
+	"size":             "small",
+	"mysql_connection": []interface{}{map[string]interface{}{"name": "mysql_connection"}},
+	"text_columns":     []interface{}{"mysql.table.unsupported_type_1"},
+	"ignore_columns":   []interface{}{"mysql.table.ignored_column"},
+	"table": []interface{}{
+		map[string]interface{}{"name": "mysql.name1", "alias": "alias"},
+		map[string]interface{}{"name": "mysql.name2"},
+	},
+}
+
+func TestResourceSourceMysqlCreateTable(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceMysql().Schema, inSourceMysqlTable)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM MYSQL CONNECTION "database"."schema"."mysql_connection" \(TEXT COLUMNS \(mysql.table.unsupported_type_1\), IGNORE COLUMNS \(mysql.table.ignored_column\)\) FOR TABLES \(mysql.name1 AS alias, mysql.name2 AS mysql.name2\) WITH \(SIZE = 'small'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Status
+		testhelpers.MockSourceStatusScan(mock, `WHERE mz_source_statuses.id = 'u1'`, "running", "")
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		if err := sourceMysqlCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+var inSourceMysqlAllTables = map[string]interface{}{
+	"name":             "source",
+	"schema_name":      "schema",
+	"database_name":    "database",
+	"cluster_name":     "cluster",
+	"mysql_connection": []interface{}{map[string]interface{}{"name": "mysql_connection"}},
+}
+
+func TestResourceSourceMysqlCreateAllTables(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceMysql().Schema, inSourceMysqlAllTables)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM MYSQL CONNECTION "database"."schema"."mysql_connection" FOR ALL TABLES;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Status
+		testhelpers.MockSourceStatusScan(mock, `WHERE mz_source_statuses.id = 'u1'`, "running", "")
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		if err := sourceMysqlCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSourceMysqlUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceMysql().Schema, inSourceMysqlTable)
+
+	d.SetId("u1")
+	d.Set("name", "old_source")
+	d.Set("size", "large")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SOURCE "database"."schema"."" RENAME TO "source"`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER SOURCE "database"."schema"."old_source" SET \(SIZE = 'small'\)`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER SOURCE "database"."schema"."old_source" ADD SUBSOURCE "mysql.name1" AS "alias", "mysql.name2"`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Status
+		testhelpers.MockSourceStatusScan(mock, `WHERE mz_source_statuses.id = 'u1'`, "running", "")
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		if err := sourceMysqlUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
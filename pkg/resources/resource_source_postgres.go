@@ -17,7 +17,7 @@ var sourcePostgresSchema = map[string]*schema.Schema{
 	"database_name":       DatabaseNameSchema("source", false),
 	"qualified_sql_name":  QualifiedNameSchema("source"),
 	"comment":             CommentSchema(false),
-	"cluster_name":        ObjectClusterNameSchema("source"),
+	"cluster_name":        ObjectClusterNameSchema("source", true),
 	"size":                ObjectSizeSchema("source"),
 	"postgres_connection": IdentifierSchema("postgres_connection", "The PostgreSQL connection to use in the source.", true),
 	"publication": {
@@ -68,8 +68,12 @@ var sourcePostgresSchema = map[string]*schema.Schema{
 		Optional:    true,
 		ForceNew:    true,
 	},
-	"subsource":      SubsourceSchema(),
-	"ownership_role": OwnershipRoleSchema(),
+	"subsource":             SubsourceSchema(),
+	"ownership_role":        OwnershipRoleSchema(),
+	"status":                ObjectStatusSchema("source"),
+	"error":                 ObjectStatusErrorSchema("source"),
+	"last_status_change_at": ObjectStatusChangeSchema("source"),
+	"wait_for_ready":        WaitForReadySchema("source", "running"),
 }
 
 func SourcePostgres() *schema.Resource {
@@ -85,6 +89,8 @@ func SourcePostgres() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: ReadyTimeouts(),
+
 		Schema: sourcePostgresSchema,
 	}
 }
@@ -167,6 +173,12 @@ func sourcePostgresCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 	d.SetId(i)
 
+	if waitForReady(d) {
+		if err := waitForSourceRunning(ctx, meta.(*sqlx.DB), i, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return sourceRead(ctx, d, meta)
 }
 
@@ -192,6 +204,12 @@ func sourcePostgresUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		if err := b.Resize(newSize.(string)); err != nil {
 			return diag.FromErr(err)
 		}
+
+		if waitForReady(d) {
+			if err := waitForSourceRunning(ctx, meta.(*sqlx.DB), d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	if d.HasChange("ownership_role") {
@@ -48,6 +48,9 @@ func TestResourceSourcePostgresCreateTable(t *testing.T) {
 		pp := `WHERE mz_sources.id = 'u1'`
 		testhelpers.MockSourceScan(mock, pp)
 
+		// Query Status
+		testhelpers.MockSourceStatusScan(mock, `WHERE mz_source_statuses.id = 'u1'`, "running", "")
+
 		// Query Subsources
 		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
 		testhelpers.MockSubsourceScan(mock, ps)
@@ -89,6 +92,9 @@ func TestResourceSourcePostgresCreateSchema(t *testing.T) {
 		pp := `WHERE mz_sources.id = 'u1'`
 		testhelpers.MockSourceScan(mock, pp)
 
+		// Query Status
+		testhelpers.MockSourceStatusScan(mock, `WHERE mz_source_statuses.id = 'u1'`, "running", "")
+
 		// Query Subsources
 		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
 		testhelpers.MockSubsourceScan(mock, ps)
@@ -117,6 +123,9 @@ func TestResourceSourcePostgresUpdate(t *testing.T) {
 		pp := `WHERE mz_sources.id = 'u1'`
 		testhelpers.MockSourceScan(mock, pp)
 
+		// Query Status
+		testhelpers.MockSourceStatusScan(mock, `WHERE mz_source_statuses.id = 'u1'`, "running", "")
+
 		// Query Subsources
 		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
 		testhelpers.MockSubsourceScan(mock, ps)
@@ -0,0 +1,285 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var sourceTableSchema = map[string]*schema.Schema{
+	"name":               ObjectNameSchema("table", true, false),
+	"schema_name":        SchemaNameSchema("table", false),
+	"database_name":      DatabaseNameSchema("table", false),
+	"qualified_sql_name": QualifiedNameSchema("table"),
+	"comment":            CommentSchema(false),
+	"source":             IdentifierSchema("source", "The source the table is created from.", true),
+	"upstream_name": {
+		Description: "The name of the table in the upstream database for PostgreSQL and MySQL sources, or the topic for Kafka sources.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"upstream_schema_name": {
+		Description: "The schema of the table in the upstream database for PostgreSQL and MySQL sources.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"text_columns": {
+		Description: "Decode data as text for columns that contain upstream types that are unsupported in Materialize.",
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"exclude_columns": {
+		Description: "Exclude columns of the upstream table from the table.",
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"format":                  FormatSpecSchema("format", "How to decode raw bytes from different formats into data structures Materialize can understand at runtime. Only for Kafka sources.", false),
+	"key_format":              FormatSpecSchema("key_format", "Set the key format explicitly. Only for Kafka sources.", false),
+	"value_format":            FormatSpecSchema("value_format", "Set the value format explicitly. Only for Kafka sources.", false),
+	"include_key":             sourceKafkaSchema["include_key"],
+	"include_key_alias":       sourceKafkaSchema["include_key_alias"],
+	"include_headers":         sourceKafkaSchema["include_headers"],
+	"include_headers_alias":   sourceKafkaSchema["include_headers_alias"],
+	"include_partition":       sourceKafkaSchema["include_partition"],
+	"include_partition_alias": sourceKafkaSchema["include_partition_alias"],
+	"include_offset":          sourceKafkaSchema["include_offset"],
+	"include_offset_alias":    sourceKafkaSchema["include_offset_alias"],
+	"include_timestamp":       sourceKafkaSchema["include_timestamp"],
+	"include_timestamp_alias": sourceKafkaSchema["include_timestamp_alias"],
+	"envelope":                sourceKafkaSchema["envelope"],
+	"ownership_role":          OwnershipRoleSchema(),
+}
+
+func SourceTable() *schema.Resource {
+	return &schema.Resource{
+		Description: "A table created from a source ingests a single upstream table or topic of the source. Tables can be added to and dropped from a source independently.",
+
+		CreateContext: sourceTableCreate,
+		ReadContext:   sourceTableRead,
+		UpdateContext: sourceTableUpdate,
+		DeleteContext: sourceTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: sourceTableSchema,
+	}
+}
+
+func sourceTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	s, err := materialize.ScanSourceTable(meta.(*sqlx.DB), i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(i)
+
+	if err := d.Set("name", s.TableName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("schema_name", s.SchemaName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("database_name", s.DatabaseName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	source := []interface{}{
+		map[string]interface{}{
+			"name":          s.SourceName.String,
+			"schema_name":   s.SourceSchemaName.String,
+			"database_name": s.SourceDatabaseName.String,
+		},
+	}
+	if err := d.Set("source", source); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("upstream_name", s.UpstreamName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("upstream_schema_name", s.UpstreamSchemaName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("comment", s.Comment.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("ownership_role", s.OwnerName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	qn := materialize.QualifiedName(s.DatabaseName.String, s.SchemaName.String, s.TableName.String)
+	if err := d.Set("qualified_sql_name", qn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func sourceTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "TABLE", Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewSourceTableBuilder(meta.(*sqlx.DB), o)
+
+	if v, ok := d.GetOk("source"); ok {
+		source := materialize.GetIdentifierSchemaStruct(databaseName, schemaName, v)
+		b.Source(source)
+	}
+
+	b.UpstreamName(d.Get("upstream_name").(string))
+
+	if v, ok := d.GetOk("upstream_schema_name"); ok {
+		b.UpstreamSchemaName(v.(string))
+	}
+
+	if v, ok := d.GetOk("text_columns"); ok {
+		b.TextColumns(materialize.GetSliceValueString(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("exclude_columns"); ok {
+		b.ExcludeColumns(materialize.GetSliceValueString(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("format"); ok {
+		b.Format(materialize.GetFormatSpecStruc(v))
+	}
+
+	if v, ok := d.GetOk("key_format"); ok {
+		b.KeyFormat(materialize.GetFormatSpecStruc(v))
+	}
+
+	if v, ok := d.GetOk("value_format"); ok {
+		b.ValueFormat(materialize.GetFormatSpecStruc(v))
+	}
+
+	b.Include(materialize.SourceTableIncludeStruct{
+		Key:            d.Get("include_key").(bool),
+		KeyAlias:       d.Get("include_key_alias").(string),
+		Headers:        d.Get("include_headers").(bool),
+		HeadersAlias:   d.Get("include_headers_alias").(string),
+		Partition:      d.Get("include_partition").(bool),
+		PartitionAlias: d.Get("include_partition_alias").(string),
+		Offset:         d.Get("include_offset").(bool),
+		OffsetAlias:    d.Get("include_offset_alias").(string),
+		Timestamp:      d.Get("include_timestamp").(bool),
+		TimestampAlias: d.Get("include_timestamp_alias").(string),
+	})
+
+	if v, ok := d.GetOk("envelope"); ok {
+		b.Envelope(materialize.GetSourceKafkaEnelopeStruct(v))
+	}
+
+	// create resource
+	if err := b.Create(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// ownership
+	if v, ok := d.GetOk("ownership_role"); ok {
+		ownership := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
+
+		if err := ownership.Alter(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed ownership, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// object comment
+	if v, ok := d.GetOk("comment"); ok {
+		comment := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+		if err := comment.Object(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// set id
+	i, err := materialize.SourceTableId(meta.(*sqlx.DB), o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(i)
+
+	return sourceTableRead(ctx, d, meta)
+}
+
+func sourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "TABLE", Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
+
+	if d.HasChange("name") {
+		oldName, newName := d.GetChange("name")
+		o := materialize.MaterializeObject{ObjectType: "TABLE", Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
+		b := materialize.NewSourceTableBuilder(meta.(*sqlx.DB), o)
+
+		if err := b.Rename(newName.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("ownership_role") {
+		_, newRole := d.GetChange("ownership_role")
+		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
+
+		if err := b.Alter(newRole.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		_, newComment := d.GetChange("comment")
+		b := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+		if err := b.Object(newComment.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return sourceTableRead(ctx, d, meta)
+}
+
+func sourceTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewSourceTableBuilder(meta.(*sqlx.DB), o)
+
+	if err := b.Drop(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
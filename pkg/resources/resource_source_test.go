@@ -28,6 +28,9 @@ func TestResourceSourceUpdate(t *testing.T) {
 		pp := `WHERE mz_sources.id = 'u1'`
 		testhelpers.MockSourceScan(mock, pp)
 
+		// Query Status
+		testhelpers.MockSourceStatusScan(mock, `WHERE mz_source_statuses.id = 'u1'`, "running", "")
+
 		// Query Subsources
 		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
 		testhelpers.MockSubsourceScan(mock, ps)
@@ -48,10 +51,14 @@ func TestResourceSourceDelete(t *testing.T) {
 	}
 	d := schema.TestResourceDataRaw(t, SourcePostgres().Schema, in)
 	r.NotNil(d)
+	d.SetId("u1")
 
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP SOURCE "database"."schema"."source"`).WillReturnResult(sqlmock.NewResult(1, 1))
 
+		// Query dropped object
+		mock.ExpectQuery(`SELECT .* WHERE mz_sources.id = \$1;`).WithArgs("u1").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
 		if err := sourceDelete(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
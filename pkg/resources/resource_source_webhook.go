@@ -147,8 +147,11 @@ var sourceWebhookSchema = map[string]*schema.Schema{
 		Optional:    true,
 		ForceNew:    true,
 	},
-	"subsource":      SubsourceSchema(),
-	"ownership_role": OwnershipRoleSchema(),
+	"subsource":             SubsourceSchema(),
+	"ownership_role":        OwnershipRoleSchema(),
+	"status":                ObjectStatusSchema("source"),
+	"error":                 ObjectStatusErrorSchema("source"),
+	"last_status_change_at": ObjectStatusChangeSchema("source"),
 }
 
 func SourceWebhook() *schema.Resource {
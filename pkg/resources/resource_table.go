@@ -3,7 +3,9 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 
@@ -19,17 +21,17 @@ var tableSchema = map[string]*schema.Schema{
 	"qualified_sql_name": QualifiedNameSchema("table"),
 	"comment":            CommentSchema(false),
 	"column": {
-		Description: "Column of the table.",
+		Description: "Column of the table. Columns appended to the end of the list are added in place when they are nullable and have no default. Removing, reordering or changing the name, type, nullability or default of an existing column recreates the table. The plan only marks the column as forcing replacement; the descriptions of the column attributes explain which changes cause it.",
 		Type:        schema.TypeList,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"name": {
-					Description: "The name of the column to be created in the table.",
+					Description: "The name of the column to be created in the table. Renaming or reordering an existing column recreates the table.",
 					Type:        schema.TypeString,
 					Required:    true,
 				},
 				"type": {
-					Description: "The data type of the column indicated by name.",
+					Description: "The data type of the column indicated by name. Changing the type of an existing column recreates the table.",
 					Type:        schema.TypeString,
 					Required:    true,
 					StateFunc: func(val any) string {
@@ -41,17 +43,22 @@ var tableSchema = map[string]*schema.Schema{
 					},
 				},
 				"nullable": {
-					Description: "Do not allow the column to contain NULL values. Columns without this constraint can contain NULL values.",
+					Description: "Do not allow the column to contain NULL values. Columns without this constraint can contain NULL values. Changing this on an existing column or appending a column with this constraint recreates the table.",
 					Type:        schema.TypeBool,
 					Optional:    true,
 					Default:     false,
 				},
+				"default": {
+					Description:      "The default value of the column, as a SQL expression. Changing the default of an existing column or appending a column with a default recreates the table.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: suppressExpressionDiff,
+				},
 				"comment": CommentSchema(false),
 			},
 		},
 		Optional: true,
 		MinItems: 1,
-		ForceNew: true,
 	},
 	"ownership_role": OwnershipRoleSchema(),
 }
@@ -65,6 +72,8 @@ func Table() *schema.Resource {
 		UpdateContext: tableUpdate,
 		DeleteContext: tableDelete,
 
+		CustomizeDiff: tableCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -119,7 +128,7 @@ func tableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	}
 	var tc []interface{}
 	for _, t := range tableColumns {
-		column := map[string]interface{}{"name": t.Name.String, "type": t.Type.String, "nullable": !t.Nullable.Bool, "comment": t.Comment.String}
+		column := map[string]interface{}{"name": t.Name.String, "type": t.Type.String, "nullable": !t.Nullable.Bool, "default": t.Default.String, "comment": t.Comment.String}
 		tc = append(tc, column)
 	}
 	if err := d.Set("column", tc); err != nil {
@@ -232,14 +241,27 @@ func tableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("columns") {
-		_, newColumns := d.GetChange("columns")
-		columns := materialize.GetTableColumnStruct(newColumns.([]interface{}))
+	if d.HasChange("column") {
+		oc, nc := d.GetChange("column")
+		oldColumns := materialize.GetTableColumnStruct(oc.([]interface{}))
+		newColumns := materialize.GetTableColumnStruct(nc.([]interface{}))
+
+		// Columns appended to the list, other changes recreate the table
+		b := materialize.NewTableBuilder(meta.(*sqlx.DB), o)
+		for _, c := range newColumns[len(oldColumns):] {
+			if err := b.AddColumn(c); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
 		comment := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+		for i, c := range newColumns {
+			var oldComment string
+			if i < len(oldColumns) {
+				oldComment = oldColumns[i].Comment
+			}
 
-		// Reset all comments if change present
-		for _, c := range columns {
-			if c.Comment != "" {
+			if c.Comment != oldComment {
 				if err := comment.Column(c.ColName, c.Comment); err != nil {
 					return diag.FromErr(err)
 				}
@@ -250,6 +272,77 @@ func tableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	return tableRead(ctx, d, meta)
 }
 
+func tableCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("column") {
+		return nil
+	}
+
+	o, n := d.GetChange("column")
+	oldColumns := materialize.GetTableColumnStruct(o.([]interface{}))
+	newColumns := materialize.GetTableColumnStruct(n.([]interface{}))
+
+	// CustomizeDiff cannot return warnings, so the reasons only reach the
+	// provider log. The column attribute descriptions document them instead
+	if r := tableColumnsReplaceReasons(oldColumns, newColumns); len(r) > 0 {
+		log.Printf("[WARN] table %s must be recreated because %s", d.Get("name").(string), strings.Join(r, ", "))
+		return d.ForceNew("column")
+	}
+
+	return nil
+}
+
+// Reports why the columns cannot be changed in place. Materialize can only
+// append nullable columns without a default to an existing table
+func tableColumnsReplaceReasons(oldColumns, newColumns []materialize.TableColumn) []string {
+	var r []string
+
+	for i, c := range oldColumns {
+		if i >= len(newColumns) {
+			r = append(r, fmt.Sprintf("column %s is removed", c.ColName))
+			continue
+		}
+
+		nc := newColumns[i]
+		switch {
+		case nc.ColName != c.ColName:
+			r = append(r, fmt.Sprintf("column %s is renamed or moved to %s", c.ColName, nc.ColName))
+		case columnType(nc.ColType) != columnType(c.ColType):
+			r = append(r, fmt.Sprintf("column %s changes type from %s to %s", c.ColName, c.ColType, nc.ColType))
+		case nc.NotNull != c.NotNull:
+			r = append(r, fmt.Sprintf("column %s changes nullability", c.ColName))
+		case !equivalentExpressions(nc.Default, c.Default):
+			r = append(r, fmt.Sprintf("column %s changes default", c.ColName))
+		}
+	}
+
+	for i := len(oldColumns); i < len(newColumns); i++ {
+		c := newColumns[i]
+		switch {
+		case c.NotNull:
+			r = append(r, fmt.Sprintf("new column %s is not nullable", c.ColName))
+		case c.Default != "":
+			r = append(r, fmt.Sprintf("new column %s has a default", c.ColName))
+		}
+	}
+
+	return r
+}
+
+func columnType(t string) string {
+	if alias, ok := aliases[t]; ok {
+		return alias
+	}
+	return t
+}
+
+func suppressExpressionDiff(k, old, new string, d *schema.ResourceData) bool {
+	return equivalentExpressions(old, new)
+}
+
+func equivalentExpressions(a, b string) bool {
+	return materialize.NormalizeStatement(a, materialize.SearchPath{}) == materialize.NormalizeStatement(b, materialize.SearchPath{})
+}
+
 func tableDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	tableName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -21,6 +22,16 @@ var inTable = map[string]interface{}{
 	"column":         []interface{}{map[string]interface{}{"name": "column", "type": "text", "nullable": true, "comment": "column comment"}},
 }
 
+// A nullable column without a default that can be appended to an existing table
+var inTableAppend = map[string]interface{}{
+	"name":           "table",
+	"schema_name":    "schema",
+	"database_name":  "database",
+	"ownership_role": "joe",
+	"comment":        "object comment",
+	"column":         []interface{}{map[string]interface{}{"name": "column", "type": "text", "comment": "column comment"}},
+}
+
 func TestResourceTableCreate(t *testing.T) {
 	r := require.New(t)
 	d := schema.TestResourceDataRaw(t, Table().Schema, inTable)
@@ -55,6 +66,72 @@ func TestResourceTableCreate(t *testing.T) {
 	})
 }
 
+func TestResourceTableUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Table().Schema, inTableAppend)
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// The raw state has no columns so the configured column is appended
+		mock.ExpectExec(`ALTER TABLE "database"."schema"."" RENAME TO "table";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER TABLE "database"."schema"."table" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`COMMENT ON TABLE "database"."schema"."table" IS 'object comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER TABLE "database"."schema"."table" ADD COLUMN column text;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`COMMENT ON COLUMN "database"."schema"."table"."column" IS 'column comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_tables.id = 'u1'`
+		testhelpers.MockTableScan(mock, pp)
+
+		// Query Columns
+		cp := `WHERE mz_columns.id = 'u1'`
+		testhelpers.MockTableColumnScan(mock, cp)
+
+		if err := tableUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestTableColumnsReplaceReasons(t *testing.T) {
+	r := require.New(t)
+
+	columns := []materialize.TableColumn{
+		{ColName: "id", ColType: "int", NotNull: true},
+		{ColName: "created_at", ColType: "timestamp", Default: "now()"},
+	}
+
+	// Appending a nullable column and changing comments or type aliases is in place
+	r.Empty(tableColumnsReplaceReasons(columns, []materialize.TableColumn{
+		{ColName: "id", ColType: "integer", NotNull: true, Comment: "identifier"},
+		{ColName: "created_at", ColType: "timestamp", Default: "NOW()"},
+		{ColName: "name", ColType: "text"},
+	}))
+
+	r.Equal([]string{"column created_at is removed"}, tableColumnsReplaceReasons(columns, columns[:1]))
+
+	r.Equal([]string{"column id changes type from int to text"}, tableColumnsReplaceReasons(columns, []materialize.TableColumn{
+		{ColName: "id", ColType: "text", NotNull: true},
+		columns[1],
+	}))
+
+	r.Equal([]string{
+		"column id is renamed or moved to created_at",
+		"column created_at is renamed or moved to id",
+	}, tableColumnsReplaceReasons(columns, []materialize.TableColumn{columns[1], columns[0]}))
+
+	r.Equal([]string{
+		"new column name is not nullable",
+		"new column email has a default",
+	}, tableColumnsReplaceReasons(columns, []materialize.TableColumn{
+		columns[0],
+		columns[1],
+		{ColName: "name", ColType: "text", NotNull: true},
+		{ColName: "email", ColType: "text", Default: "''"},
+	}))
+}
+
 func TestResourceTableDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -115,6 +115,22 @@ func typeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
+	// The properties are only read when they are not configured, such as on
+	// import, so types configured with an alias like integer keep their name
+	if _, ok := d.GetOk("list_properties"); !ok && s.ElementType.Valid {
+		p := []interface{}{map[string]interface{}{"element_type": s.ElementType.String}}
+		if err := d.Set("list_properties", p); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if _, ok := d.GetOk("map_properties"); !ok && s.KeyType.Valid {
+		p := []interface{}{map[string]interface{}{"key_type": s.KeyType.String, "value_type": s.ValueType.String}}
+		if err := d.Set("map_properties", p); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if err := d.Set("ownership_role", s.OwnerName.String); err != nil {
 		return diag.FromErr(err)
 	}
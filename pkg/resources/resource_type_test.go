@@ -42,6 +42,24 @@ func TestResourceTypeCreate(t *testing.T) {
 	})
 }
 
+func TestResourceTypeReadImport(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Type().Schema, map[string]interface{}{})
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockTypeScan(mock, `WHERE mz_types.id = 'u1'`)
+
+		if err := typeRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("int4", d.Get("list_properties.0.element_type"))
+		r.Equal(0, d.Get("map_properties.#"))
+	})
+}
+
 func TestResourceTypeDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -3,7 +3,9 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 
@@ -19,12 +21,13 @@ var viewSchema = map[string]*schema.Schema{
 	"qualified_sql_name": QualifiedNameSchema("view"),
 	"comment":            CommentSchema(false),
 	"statement": {
-		Description: "The SQL statement for the view.",
-		Type:        schema.TypeString,
-		Required:    true,
-		ForceNew:    true,
+		Description:      "The SQL statement for the view. Changes are applied in place with `CREATE OR REPLACE VIEW`, which is only possible while no other objects depend on the view.",
+		Type:             schema.TypeString,
+		Required:         true,
+		DiffSuppressFunc: suppressStatementDiff,
 	},
-	"ownership_role": OwnershipRoleSchema(),
+	"ownership_role":       OwnershipRoleSchema(),
+	"statement_validation": StatementValidationSchema("view"),
 }
 
 func View() *schema.Resource {
@@ -36,6 +39,8 @@ func View() *schema.Resource {
 		UpdateContext: viewUpdate,
 		DeleteContext: viewDelete,
 
+		CustomizeDiff: viewCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -82,6 +87,10 @@ func viewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
+	if err := setStatement(meta.(*sqlx.DB), d, s.CreateSql.String); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -151,6 +160,12 @@ func viewUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 	}
 
+	if d.HasChange("statement") {
+		if err := viewReplace(d, meta.(*sqlx.DB), o); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
 		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
@@ -172,6 +187,86 @@ func viewUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	return viewRead(ctx, d, meta)
 }
 
+// Replacing a view creates a new catalog object so the ownership, comment and
+// privileges of the previous view are carried over
+func viewReplace(d *schema.ResourceData, conn *sqlx.DB, o materialize.MaterializeObject) error {
+	p, err := materialize.ScanView(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	b := materialize.NewViewBuilder(conn, o)
+	b.SelectStmt(d.Get("statement").(string))
+
+	if err := b.Replace(); err != nil {
+		return err
+	}
+
+	i, err := materialize.ViewId(conn, o)
+	if err != nil {
+		return err
+	}
+	if i != d.Id() {
+		log.Printf("[DEBUG] view %s replaced, id changed from %s to %s", o.Name, d.Id(), i)
+		d.SetId(i)
+	}
+
+	if v, ok := d.GetOk("ownership_role"); ok && !d.HasChange("ownership_role") {
+		if err := materialize.NewOwnershipBuilder(conn, o).Alter(v.(string)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("comment"); ok && !d.HasChange("comment") {
+		if err := materialize.NewCommentBuilder(conn, o).Object(v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return restorePrivileges(conn, p.Privileges.String, o)
+}
+
+func viewCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("statement") {
+		if err := viewCheckReplace(meta.(*sqlx.DB), d.Id(), d.Get("name").(string)); err != nil {
+			return err
+		}
+	}
+
+	return validateStatement(d, meta, func(method string) error {
+		o := materialize.MaterializeObject{
+			Name:         d.Get("name").(string),
+			SchemaName:   d.Get("schema_name").(string),
+			DatabaseName: d.Get("database_name").(string),
+		}
+		b := materialize.NewViewBuilder(meta.(*sqlx.DB), o).SelectStmt(d.Get("statement").(string))
+		return b.Validate(method)
+	})
+}
+
+// A view can only be replaced in place if nothing depends on it
+func viewCheckReplace(conn *sqlx.DB, id, name string) error {
+	deps, err := materialize.ListDependents(conn, id)
+	if err != nil {
+		return err
+	}
+
+	if len(deps) == 0 {
+		return nil
+	}
+
+	var o []string
+	for _, dep := range deps {
+		qn := materialize.QualifiedName(dep.DatabaseName.String, dep.SchemaName.String, dep.ObjectName.String)
+		o = append(o, fmt.Sprintf("%s %s", dep.Type.String, qn))
+	}
+
+	return fmt.Errorf(
+		"statement of view %s cannot be replaced in place because it is depended upon by: %s. Remove or update the dependent objects before changing the statement",
+		name, strings.Join(o, ", "),
+	)
+}
+
 func viewDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	viewName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
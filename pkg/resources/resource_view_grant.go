@@ -41,7 +41,7 @@ func GrantView() *schema.Resource {
 		DeleteContext: grantViewDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: grantImport,
 		},
 
 		Schema: grantViewSchema,
@@ -73,3 +73,36 @@ func TestResourceGrantViewDelete(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceGrantViewReadReplaced(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"role_name":     "joe",
+		"privilege":     "SELECT",
+		"view_name":     "view",
+		"schema_name":   "schema",
+		"database_name": "database",
+	}
+	d := schema.TestResourceDataRaw(t, GrantView().Schema, in)
+	r.NotNil(d)
+	d.SetId("GRANT|VIEW|u2|u1|SELECT")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Previous view id no longer exists
+		mock.ExpectQuery(`SELECT .* WHERE mz_views.id = \$1;`).WithArgs("u2").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		// Query replaced view by name
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'view'`
+		testhelpers.MockViewScan(mock, ip)
+
+		// Query Params
+		testhelpers.MockViewScan(mock, `WHERE mz_views.id = 'u1'`)
+
+		if err := grantRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("GRANT|VIEW|u1|u1|SELECT", d.Id())
+	})
+}
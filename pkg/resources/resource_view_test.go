@@ -35,6 +35,7 @@ func TestResourceViewCreate(t *testing.T) {
 		// Query Params
 		pp := `WHERE mz_views.id = 'u1'`
 		testhelpers.MockViewScan(mock, pp)
+		testhelpers.MockSearchPathScan(mock)
 
 		if err := viewCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -54,16 +55,60 @@ func TestResourceViewUpdate(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`ALTER VIEW "database"."schema"."" RENAME TO "view";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		// Query Params
+		// Query current privileges
 		pp := `WHERE mz_views.id = 'u1'`
 		testhelpers.MockViewScan(mock, pp)
 
+		// Replace
+		mock.ExpectExec(`CREATE OR REPLACE VIEW "database"."schema"."old_view" AS SELECT 1 FROM 1;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'old_view'`
+		testhelpers.MockViewScan(mock, ip)
+
+		// Restore privileges
+		rp := `WHERE mz_roles.id = 'u1'`
+		testhelpers.MockRoleScan(mock, rp)
+		mock.ExpectExec(`GRANT SELECT ON TABLE "database"."schema"."old_view" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		testhelpers.MockViewScan(mock, pp)
+		testhelpers.MockSearchPathScan(mock)
+
 		if err := viewUpdate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
 	})
 }
 
+func TestResourceViewCheckReplace(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_object_dependencies.referenced_object_id = 'u1'`
+		testhelpers.MockDependentScan(mock, p)
+
+		err := viewCheckReplace(db, "u1", "view")
+		if err == nil {
+			t.Fatal("expected replace to be blocked by dependent index")
+		}
+
+		e := `statement of view view cannot be replaced in place because it is depended upon by: index "database"."schema"."index". Remove or update the dependent objects before changing the statement`
+		if err.Error() != e {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+}
+
+func TestResourceViewCheckReplaceNoDependents(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`WHERE mz_object_dependencies.referenced_object_id = \$1;`).WithArgs("u1").
+			WillReturnRows(mock.NewRows([]string{"object_id", "referenced_object_id", "object_name", "schema_name", "database_name", "type"}))
+
+		if err := viewCheckReplace(db, "u1", "view"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestResourceViewDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -1,15 +1,19 @@
 package resources
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
-	defaultSchema   = "public"
-	defaultDatabase = "materialize"
+	defaultSchema       = "public"
+	defaultDatabase     = "materialize"
+	defaultReadyTimeout = 10 * time.Minute
 )
 
 func ObjectNameSchema(resource string, required, forceNew bool) *schema.Schema {
@@ -283,6 +287,50 @@ func SinkFormatSpecSchema(elem string, description string, required bool) *schem
 								Optional:    true,
 								ForceNew:    true,
 							},
+							"avro_doc_type": {
+								Description: "Add top level documentation comment to the generated Avro schemas.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								ForceNew:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"object": IdentifierSchema("object", "The object to apply the Avro documentation.", true),
+										"doc": {
+											Description: "Documentation string.",
+											Type:        schema.TypeString,
+											Required:    true,
+											ForceNew:    true,
+										},
+										"key":   avroDocSchema("key"),
+										"value": avroDocSchema("value"),
+									},
+								},
+							},
+							"avro_doc_column": {
+								Description: "Add column level documentation comment to the generated Avro schemas.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								ForceNew:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"object": IdentifierSchema("object", "The object to apply the Avro documentation.", true),
+										"column": {
+											Description: "Name of the column in the Avro schema to apply to.",
+											Type:        schema.TypeString,
+											Required:    true,
+											ForceNew:    true,
+										},
+										"doc": {
+											Description: "Documentation string.",
+											Type:        schema.TypeString,
+											Required:    true,
+											ForceNew:    true,
+										},
+										"key":   avroDocSchema("key"),
+										"value": avroDocSchema("value"),
+									},
+								},
+							},
 						},
 					},
 				},
@@ -292,6 +340,18 @@ func SinkFormatSpecSchema(elem string, description string, required bool) *schem
 					Optional:    true,
 					ForceNew:    true,
 				},
+				"text": {
+					Description: "Text format. Only supported for single column keys and values.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"bytes": {
+					Description: "BYTES format. Only supported for single column keys and values of type `bytea`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+				},
 			},
 		},
 		Required:    required,
@@ -303,6 +363,15 @@ func SinkFormatSpecSchema(elem string, description string, required bool) *schem
 	}
 }
 
+func avroDocSchema(schemaType string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("Applies only to the %s schema. If neither `key` nor `value` is set the documentation applies to both schemas.", schemaType),
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+	}
+}
+
 func SubsourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Description: "Subsources of a source.",
@@ -322,15 +391,19 @@ func SubsourceSchema() *schema.Schema {
 	}
 }
 
-func ObjectClusterNameSchema(objectType string) *schema.Schema {
+func ObjectClusterNameSchema(objectType string, forceNew bool) *schema.Schema {
+	d := fmt.Sprintf("The cluster to maintain this %s. If not specified, the `size` option must be specified.", objectType)
+	if !forceNew {
+		d += fmt.Sprintf(" Changing the cluster moves the %s to the new cluster.", objectType)
+	}
 	return &schema.Schema{
-		Description:   fmt.Sprintf("The cluster to maintain this %s. If not specified, the `size` option must be specified.", objectType),
+		Description:   d,
 		Type:          schema.TypeString,
 		Optional:      true,
 		Computed:      true,
 		AtLeastOneOf:  []string{"cluster_name", "size"},
 		ConflictsWith: []string{"size"},
-		ForceNew:      true,
+		ForceNew:      forceNew,
 	}
 }
 
@@ -450,3 +523,79 @@ func CommentSchema(forceNew bool) *schema.Schema {
 		ForceNew:    forceNew,
 	}
 }
+
+func WaitForReadySchema(resource, state string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("Wait until the %s is %s before completing the create or update. Polling is bounded by the resource `timeouts`.", resource, state),
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+func ReadyTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultReadyTimeout),
+		Update: schema.DefaultTimeout(defaultReadyTimeout),
+		Delete: schema.DefaultTimeout(defaultReadyTimeout),
+	}
+}
+
+// Imports the resource by id and sets the schema default for attributes that
+// cannot be read back from the catalog so an imported resource plans cleanly
+func importWithDefaults(s map[string]*schema.Schema, keys ...string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		for _, k := range keys {
+			if err := d.Set(k, s[k].Default); err != nil {
+				return nil, err
+			}
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func StatementValidationSchema(resource string) *schema.Schema {
+	return &schema.Schema{
+		Description:  fmt.Sprintf("How the statement is validated during plan. `explain` runs `EXPLAIN` on the statement, `transaction` creates the %s in a transaction that is rolled back and `none` skips validation. Statements that reference objects created in the same apply are validated at apply time. Defaults to `explain`.", resource),
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(materialize.ValidationMethods, false),
+	}
+}
+
+func ObjectStatusSchema(resource string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: fmt.Sprintf("The status of the %s as reported by `mz_internal.mz_%s_statuses`, such as `running`, `starting`, `stalled` or `failed`.", resource, resource),
+		Computed:    true,
+	}
+}
+
+func ObjectStatusErrorSchema(resource string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: fmt.Sprintf("The error reported by the %s when it is stalled or failed.", resource),
+		Computed:    true,
+	}
+}
+
+func ObjectStatusChangeSchema(resource string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: fmt.Sprintf("The time the status of the %s last changed.", resource),
+		Computed:    true,
+	}
+}
+
+func suppressIntervalDiff(k, old, new string, d *schema.ResourceData) bool {
+	return materialize.EquivalentIntervals(old, new)
+}
+
+func suppressTimestampDiff(k, old, new string, d *schema.ResourceData) bool {
+	return materialize.EquivalentTimestamps(old, new)
+}
+
+// Alignments default to the creation time which is read back from the catalog
+func suppressAlignedToDiff(k, old, new string, d *schema.ResourceData) bool {
+	return new == "" || materialize.EquivalentTimestamps(old, new)
+}
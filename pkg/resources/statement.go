@@ -0,0 +1,60 @@
+package resources
+
+import (
+	"sync"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+// Materialize qualifies the names in `create_sql` with the database and search
+// path of the session. Diff suppression has no access to the connection so the
+// search path is kept from the last statement that was read
+var statementSearchPath struct {
+	sync.Mutex
+	p materialize.SearchPath
+}
+
+func currentStatementSearchPath() materialize.SearchPath {
+	statementSearchPath.Lock()
+	defer statementSearchPath.Unlock()
+	return statementSearchPath.p
+}
+
+// Statements read back from the catalog are fully qualified and reformatted so
+// only differences that survive normalization are shown in the plan
+func suppressStatementDiff(k, old, new string, d *schema.ResourceData) bool {
+	p := currentStatementSearchPath()
+	return materialize.NormalizeStatement(old, p) == materialize.NormalizeStatement(new, p)
+}
+
+// Sets the statement from the `create_sql` of the object. The configured
+// statement is kept when it is equivalent so the state keeps its formatting
+func setStatement(conn *sqlx.DB, d *schema.ResourceData, createSql string) error {
+	if createSql == "" {
+		return nil
+	}
+
+	stmt := materialize.CreateStatement(createSql)
+	current := d.Get("statement").(string)
+	if current == "" {
+		return d.Set("statement", stmt)
+	}
+
+	p, err := materialize.CurrentSearchPath(conn)
+	if err != nil {
+		return err
+	}
+
+	statementSearchPath.Lock()
+	statementSearchPath.p = p
+	statementSearchPath.Unlock()
+
+	if materialize.NormalizeStatement(current, p) == materialize.NormalizeStatement(stmt, p) {
+		return nil
+	}
+
+	return d.Set("statement", stmt)
+}
@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuppressStatementDiff(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, View().Schema, map[string]interface{}{"database_name": "database", "schema_name": "schema"})
+
+	r.True(suppressStatementDiff("statement", `SELECT "a" FROM "database"."schema"."t"`, "select a\nfrom database.schema.t;", d))
+	r.False(suppressStatementDiff("statement", `SELECT "a" FROM "database"."schema"."t"`, "SELECT b FROM database.schema.t", d))
+	r.False(suppressStatementDiff("statement", "", "SELECT 1", d))
+}
+
+func TestSetStatement(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, View().Schema, map[string]interface{}{"schema_name": "schema", "statement": "select a from t"})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Unqualified names resolve to the search path of the session and not
+		// to the schema of the view
+		testhelpers.MockSearchPathScan(mock)
+		r.NoError(setStatement(db, d, `CREATE VIEW "database"."schema"."v" AS SELECT "a" FROM "database"."public"."t"`))
+		r.Equal("select a from t", d.Get("statement"))
+		r.True(suppressStatementDiff("statement", `SELECT "a" FROM "database"."public"."t"`, "select a from t", d))
+
+		// Statements changed outside of Terraform are read back
+		testhelpers.MockSearchPathScan(mock)
+		r.NoError(setStatement(db, d, `CREATE VIEW "database"."schema"."v" AS SELECT "a" FROM "database"."schema"."t"`))
+		r.Equal(`SELECT "a" FROM "database"."schema"."t"`, d.Get("statement"))
+	})
+}
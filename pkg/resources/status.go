@@ -0,0 +1,154 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+// Statuses an object cannot recover from without intervention
+var terminalStatuses = []string{"failed", "dropped"}
+
+func waitForReady(d *schema.ResourceData) bool {
+	v, ok := d.GetOk("wait_for_ready")
+	return ok && v.(bool)
+}
+
+func waitForObjectRunning(ctx context.Context, conn *sqlx.DB, objectType, id string, timeout time.Duration, scan func(*sqlx.DB, string) (materialize.ObjectStatusParams, error)) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		s, err := scan(conn, id)
+		if err == sql.ErrNoRows {
+			return retry.RetryableError(fmt.Errorf("%s %s has not reported a status yet", objectType, id))
+		} else if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		status := s.Status.String
+		if status == "running" {
+			return nil
+		}
+
+		e := fmt.Errorf("%s %s is %s", objectType, s.ObjectName.String, status)
+		if s.Error.String != "" {
+			e = fmt.Errorf("%s %s is %s: %s", objectType, s.ObjectName.String, status, s.Error.String)
+		}
+
+		for _, t := range terminalStatuses {
+			if status == t {
+				return retry.NonRetryableError(e)
+			}
+		}
+
+		return retry.RetryableError(e)
+	})
+}
+
+// Polls the catalog until the dropped object no longer exists
+func waitForObjectDropped(ctx context.Context, objectType, id string, timeout time.Duration, scan func() error) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		err := scan()
+		if err == sql.ErrNoRows {
+			return nil
+		} else if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		return retry.RetryableError(fmt.Errorf("%s %s has not been dropped yet", objectType, id))
+	})
+}
+
+// Polls mz_source_statuses until the source is running
+func waitForSourceRunning(ctx context.Context, conn *sqlx.DB, id string, timeout time.Duration) error {
+	return waitForObjectRunning(ctx, conn, "source", id, timeout, materialize.ScanSourceStatus)
+}
+
+// Polls mz_sink_statuses until the sink is running
+func waitForSinkRunning(ctx context.Context, conn *sqlx.DB, id string, timeout time.Duration) error {
+	return waitForObjectRunning(ctx, conn, "sink", id, timeout, materialize.ScanSinkStatus)
+}
+
+// Polls mz_cluster_replica_statuses until every process of the replica is ready
+func waitForClusterReplicaReady(ctx context.Context, conn *sqlx.DB, id string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		s, err := materialize.ListClusterReplicaStatuses(conn, id)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if len(s) == 0 {
+			return retry.RetryableError(fmt.Errorf("cluster replica %s has not reported a status yet", id))
+		}
+
+		var p []string
+		for _, r := range s {
+			if r.Status.String == "ready" {
+				continue
+			}
+
+			if r.Reason.String != "" {
+				p = append(p, fmt.Sprintf("process %s is %s: %s", r.ProcessId.String, r.Status.String, r.Reason.String))
+			} else {
+				p = append(p, fmt.Sprintf("process %s is %s", r.ProcessId.String, r.Status.String))
+			}
+		}
+
+		if len(p) > 0 {
+			return retry.RetryableError(fmt.Errorf("cluster replica %s is not ready: %s", id, strings.Join(p, ", ")))
+		}
+
+		return nil
+	})
+}
+
+// Polls mz_hydration_statuses until the object is hydrated on every replica
+func waitForObjectHydrated(ctx context.Context, conn *sqlx.DB, objectType, id string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		s, err := materialize.ListObjectHydrationStatuses(conn, id)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if len(s) == 0 {
+			return retry.RetryableError(fmt.Errorf("%s %s is not scheduled on any replica", objectType, id))
+		}
+
+		var r []string
+		for _, h := range s {
+			if !h.Hydrated.Bool {
+				r = append(r, h.ReplicaName.String)
+			}
+		}
+
+		if len(r) > 0 {
+			return retry.RetryableError(fmt.Errorf("%s %s is not hydrated on replicas: %s", objectType, s[0].ObjectName.String, strings.Join(r, ", ")))
+		}
+
+		return nil
+	})
+}
+
+// Sets the computed status attributes. Objects that have not reported a
+// status yet are stored with empty values
+func setObjectStatus(d *schema.ResourceData, conn *sqlx.DB, id string, scan func(*sqlx.DB, string) (materialize.ObjectStatusParams, error)) error {
+	s, err := scan(conn, id)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	if err := d.Set("status", s.Status.String); err != nil {
+		return err
+	}
+
+	if err := d.Set("error", s.Error.String); err != nil {
+		return err
+	}
+
+	return d.Set("last_status_change_at", s.LastStatusChangeAt.String)
+}
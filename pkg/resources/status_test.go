@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestWaitForSourceRunning(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_source_statuses.id = 'u1'`
+		testhelpers.MockSourceStatusScan(mock, p, "running", "")
+
+		if err := waitForSourceRunning(context.TODO(), db, "u1", time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestWaitForSourceRunningFailed(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_source_statuses.id = 'u1'`
+		testhelpers.MockSourceStatusScan(mock, p, "failed", "kafka: topic does not exist")
+
+		err := waitForSourceRunning(context.TODO(), db, "u1", time.Minute)
+		if err == nil || !strings.Contains(err.Error(), "source source is failed: kafka: topic does not exist") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestWaitForSinkRunning(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_sink_statuses.id = 'u1'`
+		testhelpers.MockSinkStatusScan(mock, p, "running", "")
+
+		if err := waitForSinkRunning(context.TODO(), db, "u1", time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestWaitForClusterReplicaReady(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_cluster_replica_statuses.replica_id = 'u1'`
+		testhelpers.MockClusterReplicaStatusScan(mock, p, "ready", "")
+
+		if err := waitForClusterReplicaReady(context.TODO(), db, "u1", time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestWaitForObjectHydrated(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_hydration_statuses.object_id = 'u1'`
+		testhelpers.MockHydrationScan(mock, p, true)
+
+		if err := waitForObjectHydrated(context.TODO(), db, "materialized view", "u1", time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
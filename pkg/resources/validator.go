@@ -2,6 +2,7 @@ package resources
 
 import (
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
@@ -36,3 +37,43 @@ func validPrivileges(objType string) schema.SchemaValidateFunc {
 		return warnings, errors
 	}
 }
+
+func validateInterval(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := materialize.ParseInterval(v); err != nil {
+		errors = append(errors, fmt.Errorf("expected %s to be a fixed length interval such as '1 day', got: %s", k, err))
+	}
+	return warnings, errors
+}
+
+// Validates the statement of a view or materialized view during plan so
+// errors surface before any resource is applied
+func validateStatement(d *schema.ResourceDiff, meta interface{}, validate func(method string) error) error {
+	if meta == nil || (d.Id() != "" && !d.HasChange("statement")) {
+		return nil
+	}
+
+	// The statement or the object name may depend on resources that are not
+	// created yet
+	for _, k := range []string{"statement", "name", "schema_name", "database_name"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	method := d.Get("statement_validation").(string)
+	if err := validate(method); err != nil {
+		if materialize.UndefinedObject(err) {
+			log.Printf("[DEBUG] skipping statement validation of %s, it references objects that do not exist yet: %s", d.Get("name"), err)
+			return nil
+		}
+		return fmt.Errorf("invalid statement: %w", err)
+	}
+
+	return nil
+}
@@ -1,17 +1,41 @@
 package testhelpers
 
 import (
+	"database/sql/driver"
 	"fmt"
+	"regexp"
 	"strings"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 )
 
-func mockQueryBuilder(query, predicate, order string) string {
+// Matches literal values in test predicates such as `WHERE mz_roles.id = 'u1'`,
+// `mz_roles.name LIKE 'prod_%'` or `mz_objects.type IN \('source', 'view'\)`
+var literalPredicate = regexp.MustCompile(`(\S+) (=|LIKE) '([^']*)'|(\S+) IN \\\(((?:'[^']*'(?:, )?)+)\\\)`)
+var literalValue = regexp.MustCompile(`'([^']*)'`)
+
+// Converts the literal values of the predicate into the bind parameters used by
+// the query and returns the expected arguments
+func mockQueryBuilder(query, predicate, order string) (string, []driver.Value) {
 	q := strings.Builder{}
 	q.WriteString(query)
 
+	var args []driver.Value
 	if predicate != "" {
+		predicate = literalPredicate.ReplaceAllStringFunc(predicate, func(m string) string {
+			s := literalPredicate.FindStringSubmatch(m)
+			if s[1] != "" {
+				args = append(args, s[3])
+				return fmt.Sprintf(`%s %s \$%d`, s[1], s[2], len(args))
+			}
+
+			var b []string
+			for _, v := range literalValue.FindAllStringSubmatch(s[5], -1) {
+				args = append(args, v[1])
+				b = append(b, fmt.Sprintf(`\$%d`, len(args)))
+			}
+			return fmt.Sprintf(`%s IN \(%s\)`, s[4], strings.Join(b, ", "))
+		})
 		q.WriteString(fmt.Sprintf(" %s", predicate))
 	}
 
@@ -21,7 +45,7 @@ func mockQueryBuilder(query, predicate, order string) string {
 
 	q.WriteString(`;`)
 
-	return q.String()
+	return q.String(), args
 }
 
 func MockClusterReplicaScan(mock sqlmock.Sqlmock, predicate string) {
@@ -44,10 +68,10 @@ func MockClusterReplicaScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_cluster_replicas.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
+	q, args := mockQueryBuilder(b, predicate, "")
 	ir := mock.NewRows([]string{"id", "replica_name", "cluster_name", "size", "availability_zone", "disk", "comment"}).
 		AddRow("u1", "replica", "cluster", "small", "use1-az2", false, "comment")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockClusterScan(mock sqlmock.Sqlmock, predicate string) {
@@ -59,12 +83,16 @@ func MockClusterScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_clusters.size,
 		mz_clusters.replication_factor,
 		mz_clusters.disk,
+		mz_cluster_schedules.type AS schedule_type,
+		mz_cluster_schedules.refresh_hydration_time_estimate::text AS hydration_time_estimate,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
 		mz_clusters.privileges
 	FROM mz_clusters
 	JOIN mz_roles
 		ON mz_clusters.owner_id = mz_roles.id
+	LEFT JOIN mz_internal.mz_cluster_schedules
+		ON mz_clusters.id = mz_cluster_schedules.cluster_id
 	LEFT JOIN \(
 		SELECT id, comment
 		FROM mz_internal.mz_comments
@@ -72,10 +100,10 @@ func MockClusterScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_clusters.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "name", "managed", "size", "replication_factor", "disk", "comment", "owner_name", "privileges"}).
-		AddRow("u1", "cluster", true, "small", 2, true, "comment", "joe", "{u1=UC/u18}")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "managed", "size", "replication_factor", "disk", "schedule_type", "hydration_time_estimate", "comment", "owner_name", "privileges"}).
+		AddRow("u1", "cluster", true, "small", 2, true, "manual", nil, "comment", "joe", "{u1=UC/u18}")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockConnectionScan(mock sqlmock.Sqlmock, predicate string) {
@@ -88,7 +116,8 @@ func MockConnectionScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_connections.type AS connection_type,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_connections.privileges
+		mz_connections.privileges,
+		mz_connections.create_sql
 	FROM mz_connections
 	JOIN mz_schemas
 		ON mz_connections.schema_id = mz_schemas.id
@@ -103,10 +132,10 @@ func MockConnectionScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_connections.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "connection_name", "schema_name", "database_name", "connection_type", "owner_name", "privileges"}).
-		AddRow("u1", "connection", "schema", "database", "kafka", "joe", "{u1=U/u18}")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "connection_name", "schema_name", "database_name", "connection_type", "owner_name", "privileges", "create_sql"}).
+		AddRow("u1", "connection", "schema", "database", "kafka", "joe", "{u1=U/u18}", `CREATE CONNECTION "database"."schema"."connection" TO KAFKA (BROKER = 'localhost:9092', SECURITY PROTOCOL = PLAINTEXT, PROGRESS TOPIC = 'progress')`)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockConnectionAwsPrivatelinkScan(mock sqlmock.Sqlmock, predicate string) {
@@ -117,6 +146,8 @@ func MockConnectionAwsPrivatelinkScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_schemas.name AS schema_name,
 		mz_databases.name AS database_name,
 		mz_aws_privatelink_connections.principal,
+		mz_aws_privatelink_connection_statuses.status,
+		mz_aws_privatelink_connection_statuses.last_status_change_at,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name
 	FROM mz_connections
@@ -126,6 +157,8 @@ func MockConnectionAwsPrivatelinkScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_schemas.database_id = mz_databases.id
 	LEFT JOIN mz_aws_privatelink_connections
 		ON mz_connections.id = mz_aws_privatelink_connections.id
+	LEFT JOIN mz_internal.mz_aws_privatelink_connection_statuses
+		ON mz_connections.id = mz_aws_privatelink_connection_statuses.id
 	JOIN mz_roles
 		ON mz_connections.owner_id = mz_roles.id
 	LEFT JOIN \(
@@ -135,10 +168,49 @@ func MockConnectionAwsPrivatelinkScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_connections.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "connection_name", "schema_name", "database_name", "principal"}).
-		AddRow("u1", "connection", "schema", "database", "principal")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "connection_name", "schema_name", "database_name", "principal", "status", "last_status_change_at"}).
+		AddRow("u1", "connection", "schema", "database", "principal", "available", "2023-10-01 00:00:00+00")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+func MockConnectionAwsScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_connections.id,
+		mz_connections.name AS connection_name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_aws_connections.endpoint,
+		mz_aws_connections.region,
+		mz_aws_connections.access_key_id,
+		mz_aws_connections.assume_role_arn,
+		mz_aws_connections.assume_role_session_name,
+		mz_aws_connections.principal,
+		mz_aws_connections.external_id,
+		mz_aws_connections.example_trust_policy::text AS example_trust_policy,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name
+	FROM mz_connections
+	JOIN mz_schemas
+		ON mz_connections.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	LEFT JOIN mz_internal.mz_aws_connections
+		ON mz_connections.id = mz_aws_connections.id
+	JOIN mz_roles
+		ON mz_connections.owner_id = mz_roles.id
+	LEFT JOIN \(
+		SELECT id, comment
+		FROM mz_internal.mz_comments
+		WHERE object_type = 'connection'
+	\) comments
+		ON mz_connections.id = comments.id`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "connection_name", "schema_name", "database_name", "region", "assume_role_arn", "principal", "external_id", "owner_name"}).
+		AddRow("u1", "conn", "schema", "database", "us-east-1", "arn:aws:iam::000000000000:role/materialize", "arn:aws:iam::664411391173:role/MaterializeConnection", "mz_00000000-0000-0000-0000-000000000000_u1", "joe")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockConnectionSshTunnelScan(mock sqlmock.Sqlmock, predicate string) {
@@ -168,10 +240,10 @@ func MockConnectionSshTunnelScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_connections.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
+	q, args := mockQueryBuilder(b, predicate, "")
 	ir := mock.NewRows([]string{"id", "connection_name", "schema_name", "database_name", "public_key_1", "public_key_2"}).
 		AddRow("u1", "connection", "schema", "database", "key_1", "key_2")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockDefaultPrivilegeScan(mock sqlmock.Sqlmock, predicate, objectType string) {
@@ -195,10 +267,10 @@ func MockDefaultPrivilegeScan(mock sqlmock.Sqlmock, predicate, objectType string
 	LEFT JOIN mz_databases
 		ON mz_default_privileges.database_id = mz_databases.id`
 
-	q := mockQueryBuilder(b, predicate, "")
+	q, args := mockQueryBuilder(b, predicate, "")
 	ir := mock.NewRows([]string{"object_type", "grantee_id", "grantee_name", "target_id", "target_name", "database_id", "schema_id", "privileges"}).
 		AddRow(objectType, "u1", "grantee", "u1", "target", nil, nil, "{u1=UC/u18}")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockDatabaseScan(mock sqlmock.Sqlmock, predicate string) {
@@ -219,10 +291,10 @@ func MockDatabaseScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_databases.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
+	q, args := mockQueryBuilder(b, predicate, "")
 	ir := mock.NewRows([]string{"id", "database_name", "owner_name", "privileges"}).
 		AddRow("u1", "database", "joe", "{u1=UC/u18}")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockIndexColumnScan(mock sqlmock.Sqlmock, predicate string) {
@@ -244,10 +316,10 @@ func MockIndexColumnScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_index_columns.index_id = mz_indexes.id
 		AND mz_index_columns.index_position = mz_columns.position`
 
-	q := mockQueryBuilder(b, predicate, "ORDER BY mz_columns.position")
+	q, args := mockQueryBuilder(b, predicate, "ORDER BY mz_columns.position")
 	ir := mock.NewRows([]string{"id", "name", "position", "nullable", "type", "default", "indexed_column", "index_name", "index_id"}).
 		AddRow("u1", "column", "1", "true", "integer", "", "true", "index", "u1")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockIndexScan(mock sqlmock.Sqlmock, predicate string) {
@@ -258,14 +330,20 @@ func MockIndexScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_objects.name AS obj_name,
 		mz_schemas.name AS obj_schema_name,
 		mz_databases.name AS obj_database_name,
-		comments.comment AS comment
+		mz_clusters.name AS cluster_name,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name
 	FROM mz_indexes
 	JOIN mz_objects
 		ON mz_indexes.on_id = mz_objects.id
+	JOIN mz_roles
+		ON mz_indexes.owner_id = mz_roles.id
 	LEFT JOIN mz_schemas
 		ON mz_objects.schema_id = mz_schemas.id
 	LEFT JOIN mz_databases
 		ON mz_schemas.database_id = mz_databases.id
+	LEFT JOIN mz_clusters
+		ON mz_indexes.cluster_id = mz_clusters.id
 	LEFT JOIN \(
 		SELECT id, comment
 		FROM mz_internal.mz_comments
@@ -273,10 +351,26 @@ func MockIndexScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_indexes.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "index_name", "obj_name", "obj_schema_name", "obj_database_name"}).
-		AddRow("u1", "index", "obj", "schema", "database")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "index_name", "obj_name", "obj_schema_name", "obj_database_name", "cluster_name", "owner_name"}).
+		AddRow("u1", "index", "obj", "schema", "database", "cluster", "joe")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+func MockMaterializedViewRefreshStrategyScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_materialized_view_refresh_strategies.materialized_view_id,
+		mz_materialized_view_refresh_strategies.type,
+		mz_materialized_view_refresh_strategies.interval::text AS interval,
+		mz_materialized_view_refresh_strategies.aligned_to::text AS aligned_to,
+		mz_materialized_view_refresh_strategies.at::text AS at
+	FROM mz_internal.mz_materialized_view_refresh_strategies`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"materialized_view_id", "type", "interval", "aligned_to", "at"}).
+		AddRow("u1", "every", "1 day", "1704067200000", nil)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockMaterializeViewScan(mock sqlmock.Sqlmock, predicate string) {
@@ -289,7 +383,9 @@ func MockMaterializeViewScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_clusters.name AS cluster_name,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_materialized_views.privileges
+		mz_materialized_views.privileges,
+		mz_materialized_views.create_sql,
+		mz_history_retention_strategies.value::text AS retain_history
 	FROM mz_materialized_views
 	JOIN mz_schemas
 		ON mz_materialized_views.schema_id = mz_schemas.id
@@ -304,12 +400,48 @@ func MockMaterializeViewScan(mock sqlmock.Sqlmock, predicate string) {
 		FROM mz_internal.mz_comments
 		WHERE object_type = 'materialized-view'
 	\) comments
-		ON mz_materialized_views.id = comments.id`
+		ON mz_materialized_views.id = comments.id
+	LEFT JOIN mz_internal.mz_history_retention_strategies
+		ON mz_materialized_views.id = mz_history_retention_strategies.id`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "materialized_view_name", "schema_name", "database_name", "cluster_name", "owner_name", "privileges", "create_sql", "retain_history"}).
+		AddRow("u1", "view", "schema", "database", "cluster", "joe", "{u1=r/u18}", `CREATE MATERIALIZED VIEW "database"."schema"."view" IN CLUSTER [u1] AS SELECT 1 FROM 1`, "3600000")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
 
-	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "materialized_view_name", "schema_name", "database_name", "cluster_name", "owner_name", "privileges"}).
-		AddRow("u1", "view", "schema", "database", "cluster", "joe", "{u1=r/u18}")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+func MockNetworkPolicyScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_network_policies.id,
+		mz_network_policies.name,
+		mz_roles.name AS owner_name,
+		mz_network_policies.privileges
+	FROM mz_internal.mz_network_policies
+	JOIN mz_roles
+		ON mz_network_policies.owner_id = mz_roles.id`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "owner_name", "privileges"}).
+		AddRow("u1", "office_access_policy", "joe", "{u1=U/u18}")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+func MockNetworkPolicyRuleScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_network_policy_rules.name,
+		mz_network_policy_rules.policy_id,
+		mz_network_policy_rules.action,
+		mz_network_policy_rules.direction,
+		mz_network_policy_rules.address
+	FROM mz_internal.mz_network_policy_rules`
+
+	q, args := mockQueryBuilder(b, predicate, "ORDER BY mz_network_policy_rules.name")
+	ir := mock.NewRows([]string{"name", "policy_id", "action", "direction", "address"}).
+		AddRow("minnesota", "u1", "allow", "ingress", "2.3.4.5/32").
+		AddRow("new_york", "u1", "allow", "ingress", "1.2.3.4/28")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockSystemPrivilege(mock sqlmock.Sqlmock) {
@@ -334,10 +466,10 @@ func MockRoleScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_roles.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
+	q, args := mockQueryBuilder(b, predicate, "")
 	ir := mock.NewRows([]string{"id", "role_name", "inherit"}).
 		AddRow("u1", "joe", true)
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockRoleGrantScan(mock sqlmock.Sqlmock) {
@@ -373,10 +505,10 @@ func MockSchemaScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_schemas.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
+	q, args := mockQueryBuilder(b, predicate, "")
 	ir := mock.NewRows([]string{"id", "schema_name", "database_name", "owner_name", "privileges"}).
 		AddRow("u1", "schema", "database", "joe", "{u1=UC/u18}")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockSecretScan(mock sqlmock.Sqlmock, predicate string) {
@@ -403,10 +535,10 @@ func MockSecretScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_secrets.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
+	q, args := mockQueryBuilder(b, predicate, "")
 	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "owner_name", "privileges"}).
 		AddRow("u1", "secret", "schema", "database", "joe", "{u1=U/u18}")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockSinkScan(mock sqlmock.Sqlmock, predicate string) {
@@ -421,13 +553,17 @@ func MockSinkScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_sinks.envelope_type,
 		mz_connections.name as connection_name,
 		mz_clusters.name as cluster_name,
+		mz_kafka_sinks.topic,
 		comments.comment AS comment,
-		mz_roles.name AS owner_name
+		mz_roles.name AS owner_name,
+		mz_sinks.create_sql
 	FROM mz_sinks
 	JOIN mz_schemas
 		ON mz_sinks.schema_id = mz_schemas.id
 	JOIN mz_databases
 		ON mz_schemas.database_id = mz_databases.id
+	LEFT JOIN mz_kafka_sinks
+		ON mz_sinks.id = mz_kafka_sinks.id
 	LEFT JOIN mz_connections
 		ON mz_sinks.connection_id = mz_connections.id
 	LEFT JOIN mz_clusters
@@ -441,10 +577,10 @@ func MockSinkScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_sinks.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "sink_type", "size", "envelope_type", "connection_name", "cluster_name", "owner_name"}).
-		AddRow("u1", "sink", "schema", "database", "kafka", "small", "JSON", "conn", "cluster", "joe")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "sink_type", "size", "envelope_type", "connection_name", "cluster_name", "topic", "owner_name", "create_sql"}).
+		AddRow("u1", "sink", "schema", "database", "kafka", "small", "JSON", "conn", "cluster", "topic", "joe", `CREATE SINK "database"."schema"."sink" IN CLUSTER [u1] FROM [u1 AS "database"."schema"."table"] INTO KAFKA CONNECTION [u1 AS "database"."schema"."connection"] (TOPIC = 'topic') FORMAT JSON ENVELOPE DEBEZIUM`)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockSourceScan(mock sqlmock.Sqlmock, predicate string) {
@@ -461,7 +597,8 @@ func MockSourceScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_clusters.name as cluster_name,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_sources.privileges
+		mz_sources.privileges,
+		mz_sources.create_sql
 	FROM mz_sources
 	JOIN mz_schemas
 		ON mz_sources.schema_id = mz_schemas.id
@@ -480,10 +617,10 @@ func MockSourceScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_sources.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "source_type", "size", "envelope_type", "connection_name", "cluster_name", "owner_name", "privileges"}).
-		AddRow("u1", "source", "schema", "database", "kafka", "small", "BYTES", "conn", "cluster", "joe", "{u1=r/u18}")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "source_type", "size", "envelope_type", "connection_name", "cluster_name", "owner_name", "privileges", "create_sql"}).
+		AddRow("u1", "source", "schema", "database", "kafka", "small", "BYTES", "conn", "cluster", "joe", "{u1=r/u18}", `CREATE SOURCE "database"."schema"."source" IN CLUSTER [u1] FROM KAFKA CONNECTION [u1 AS "database"."schema"."connection"] (TOPIC = 'topic') FORMAT BYTES EXPOSE PROGRESS AS [u2 AS "database"."schema"."source_progress"]`)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockSubsourceScan(mock sqlmock.Sqlmock, predicate string) {
@@ -503,10 +640,33 @@ func MockSubsourceScan(mock sqlmock.Sqlmock, predicate string) {
 	JOIN mz_databases
 		ON mz_schemas.database_id = mz_databases.id`
 
-	q := mockQueryBuilder(b, predicate, "")
+	q, args := mockQueryBuilder(b, predicate, "")
 	ir := mock.NewRows([]string{"object_id", "referenced_object_id", "object_name", "schema_name", "database_name", "type"}).
 		AddRow("u1", "u2", "object", "schema", "database", "source")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+func MockDependentScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_object_dependencies.object_id,
+		mz_object_dependencies.referenced_object_id,
+		mz_objects.name AS object_name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_objects.type
+	FROM mz_internal.mz_object_dependencies
+	JOIN mz_objects
+		ON mz_object_dependencies.object_id = mz_objects.id
+	JOIN mz_schemas
+		ON mz_objects.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"object_id", "referenced_object_id", "object_name", "schema_name", "database_name", "type"}).
+		AddRow("u2", "u1", "index", "schema", "database", "index")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockTableColumnScan(mock sqlmock.Sqlmock, predicate string) {
@@ -528,10 +688,34 @@ func MockTableColumnScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_columns.id = comments.id
 		AND mz_columns.position = comments.object_sub_id`
 
-	q := mockQueryBuilder(b, predicate, "ORDER BY mz_columns.position")
+	q, args := mockQueryBuilder(b, predicate, "ORDER BY mz_columns.position")
 	ir := mock.NewRows([]string{"id", "name", "position", "nullable", "type", "default"}).
 		AddRow("u1", "column", "1", "true", "integer", "")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+// Each column is given as name, position, nullable and type
+func MockRelationColumnScan(mock sqlmock.Sqlmock, predicate string, columns [][]string) {
+	b := `
+	SELECT
+		mz_columns.name,
+		mz_columns.position,
+		mz_columns.nullable,
+		mz_columns.type
+	FROM mz_columns
+	JOIN mz_objects
+		ON mz_columns.id = mz_objects.id
+	JOIN mz_schemas
+		ON mz_objects.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id`
+
+	q, args := mockQueryBuilder(b, predicate, "ORDER BY mz_columns.position")
+	ir := mock.NewRows([]string{"name", "position", "nullable", "type"})
+	for _, c := range columns {
+		ir.AddRow(c[0], c[1], c[2], c[3])
+	}
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockSystemGrantScan(mock sqlmock.Sqlmock) {
@@ -549,7 +733,8 @@ func MockTableScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_databases.name AS database_name,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_tables.privileges
+		mz_tables.privileges,
+		mz_tables.source_id
 	FROM mz_tables
 	JOIN mz_schemas
 		ON mz_tables.schema_id = mz_schemas.id
@@ -565,10 +750,59 @@ func MockTableScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_tables.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
+	q, args := mockQueryBuilder(b, predicate, "")
 	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "comment", "owner_name", "privileges"}).
 		AddRow("u1", "table", "schema", "database", "comment", "materialize", "{u1=arwd/u18}")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+func MockSourceTableScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_tables.id,
+		mz_tables.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_sources.name AS source_name,
+		source_schemas.name AS source_schema_name,
+		source_databases.name AS source_database_name,
+		mz_sources.type AS source_type,
+		COALESCE\(mz_postgres_source_tables.table_name, mz_mysql_source_tables.table_name, mz_kafka_source_tables.topic\) AS upstream_name,
+		COALESCE\(mz_postgres_source_tables.schema_name, mz_mysql_source_tables.schema_name\) AS upstream_schema_name,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name,
+		mz_tables.privileges
+	FROM mz_tables
+	JOIN mz_schemas
+		ON mz_tables.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_sources
+		ON mz_tables.source_id = mz_sources.id
+	JOIN mz_schemas AS source_schemas
+		ON mz_sources.schema_id = source_schemas.id
+	JOIN mz_databases AS source_databases
+		ON source_schemas.database_id = source_databases.id
+	LEFT JOIN mz_internal.mz_postgres_source_tables
+		ON mz_tables.id = mz_postgres_source_tables.table_id
+	LEFT JOIN mz_internal.mz_mysql_source_tables
+		ON mz_tables.id = mz_mysql_source_tables.table_id
+	LEFT JOIN mz_internal.mz_kafka_source_tables
+		ON mz_tables.id = mz_kafka_source_tables.table_id
+	JOIN mz_roles
+		ON mz_tables.owner_id = mz_roles.id
+	LEFT JOIN \(
+		SELECT id, comment
+		FROM mz_internal.mz_comments
+		WHERE object_type = 'table'
+		AND object_sub_id IS NULL
+	\) comments
+		ON mz_tables.id = comments.id`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "source_name", "source_schema_name", "source_database_name", "source_type", "upstream_name", "upstream_schema_name", "comment", "owner_name", "privileges"}).
+		AddRow("u1", "table", "schema", "database", "source", "public", "materialize", "postgres", "upstream_table", "upstream_schema", "comment", "materialize", "{u1=arwd/u18}")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockTypeScan(mock sqlmock.Sqlmock, predicate string) {
@@ -581,7 +815,10 @@ func MockTypeScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_types.category,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_types.privileges
+		mz_types.privileges,
+		element_types.name AS element_type,
+		key_types.name AS key_type,
+		value_types.name AS value_type
 	FROM mz_types
 	JOIN mz_schemas
 		ON mz_types.schema_id = mz_schemas.id
@@ -594,12 +831,22 @@ func MockTypeScan(mock sqlmock.Sqlmock, predicate string) {
 		FROM mz_internal.mz_comments
 		WHERE object_type = 'type'
 	\) comments
-		ON mz_types.id = comments.id`
-
-	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "category", "owner_name", "privileges"}).
-		AddRow("u1", "type", "schema", "database", "category", "joe", "{u1=U/u18}")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+		ON mz_types.id = comments.id
+	LEFT JOIN mz_list_types
+		ON mz_types.id = mz_list_types.id
+	LEFT JOIN mz_types AS element_types
+		ON mz_list_types.element_id = element_types.id
+	LEFT JOIN mz_map_types
+		ON mz_types.id = mz_map_types.id
+	LEFT JOIN mz_types AS key_types
+		ON mz_map_types.key_id = key_types.id
+	LEFT JOIN mz_types AS value_types
+		ON mz_map_types.value_id = value_types.id`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "category", "owner_name", "privileges", "element_type"}).
+		AddRow("u1", "type", "schema", "database", "category", "joe", "{u1=U/u18}", "int4")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
 }
 
 func MockViewScan(mock sqlmock.Sqlmock, predicate string) {
@@ -611,7 +858,8 @@ func MockViewScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_databases.name AS database_name,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_views.privileges
+		mz_views.privileges,
+		mz_views.create_sql
 	FROM mz_views
 	JOIN mz_schemas
 		ON mz_views.schema_id = mz_schemas.id
@@ -626,8 +874,111 @@ func MockViewScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_views.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
-	ir := sqlmock.NewRows([]string{"id", "name", "schema_name", "database_name", "owner_name", "privileges"}).
-		AddRow("u1", "view", "schema", "database", "joe", "{u1=r/u18}")
-	mock.ExpectQuery(q).WillReturnRows(ir)
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := sqlmock.NewRows([]string{"id", "name", "schema_name", "database_name", "owner_name", "privileges", "create_sql"}).
+		AddRow("u1", "view", "schema", "database", "joe", "{u1=r/u18}", `CREATE VIEW "database"."schema"."view" AS SELECT 1 FROM 1`)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+func MockHydrationScan(mock sqlmock.Sqlmock, predicate string, hydrated bool) {
+	b := `
+	SELECT
+		mz_hydration_statuses.object_id,
+		mz_objects.name AS object_name,
+		mz_hydration_statuses.replica_id,
+		mz_cluster_replicas.name AS replica_name,
+		mz_clusters.name AS cluster_name,
+		mz_hydration_statuses.hydrated
+	FROM mz_internal.mz_hydration_statuses
+	JOIN mz_objects
+		ON mz_hydration_statuses.object_id = mz_objects.id
+	JOIN mz_cluster_replicas
+		ON mz_hydration_statuses.replica_id = mz_cluster_replicas.id
+	JOIN mz_clusters
+		ON mz_cluster_replicas.cluster_id = mz_clusters.id`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"object_id", "object_name", "replica_id", "replica_name", "cluster_name", "hydrated"}).
+		AddRow("u1", "materialized_view", "u1", "r1", "cluster", hydrated)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+func MockSourceStatusScan(mock sqlmock.Sqlmock, predicate, status, e string) {
+	b := `
+	SELECT
+		mz_source_statuses.id,
+		mz_source_statuses.name,
+		mz_source_statuses.type,
+		mz_source_statuses.status,
+		mz_source_statuses.error,
+		mz_source_statuses.last_status_change_at
+	FROM mz_internal.mz_source_statuses`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "type", "status", "error", "last_status_change_at"}).
+		AddRow("u1", "source", "kafka", status, e, "2023-10-01 00:00:00+00")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+func MockSourceStatusListScan(mock sqlmock.Sqlmock, predicate, status, e string) {
+	b := `
+	SELECT
+		mz_source_statuses.id,
+		mz_source_statuses.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_source_statuses.type,
+		mz_source_statuses.status,
+		mz_source_statuses.error,
+		mz_source_statuses.last_status_change_at
+	FROM mz_internal.mz_source_statuses
+	JOIN mz_sources
+		ON mz_source_statuses.id = mz_sources.id
+	JOIN mz_schemas
+		ON mz_sources.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "type", "status", "error", "last_status_change_at"}).
+		AddRow("u1", "source", "schema", "database", "kafka", status, e, "2023-10-01 00:00:00+00")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+func MockSinkStatusScan(mock sqlmock.Sqlmock, predicate, status, e string) {
+	b := `
+	SELECT
+		mz_sink_statuses.id,
+		mz_sink_statuses.name,
+		mz_sink_statuses.type,
+		mz_sink_statuses.status,
+		mz_sink_statuses.error,
+		mz_sink_statuses.last_status_change_at
+	FROM mz_internal.mz_sink_statuses`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "type", "status", "error", "last_status_change_at"}).
+		AddRow("u1", "sink", "kafka", status, e, "2023-10-01 00:00:00+00")
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+func MockClusterReplicaStatusScan(mock sqlmock.Sqlmock, predicate, status, reason string) {
+	b := `
+	SELECT
+		mz_cluster_replica_statuses.replica_id,
+		mz_cluster_replica_statuses.process_id,
+		mz_cluster_replica_statuses.status,
+		mz_cluster_replica_statuses.reason
+	FROM mz_internal.mz_cluster_replica_statuses`
+
+	q, args := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"replica_id", "process_id", "status", "reason"}).
+		AddRow("u1", "0", status, reason)
+	mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(ir)
+}
+
+func MockSearchPathScan(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SHOW DATABASE;`).WillReturnRows(mock.NewRows([]string{"database"}).AddRow("database"))
+	ir := mock.NewRows([]string{"unnest"}).AddRow("mz_catalog").AddRow("pg_catalog").AddRow("public")
+	mock.ExpectQuery(`SELECT unnest\(current_schemas\(true\)\);`).WillReturnRows(ir)
 }